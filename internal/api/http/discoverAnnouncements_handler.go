@@ -0,0 +1,109 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// announcementSection serves announcements. A user can dismiss a notice, which is then
+// left out of their public finds.
+func (h *DiscoverHandler) announcementSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverAnnouncementsUsecase)
+	section.narrowFind = h.excludeDismissed
+	section.routes = func(public, _ *gin.RouterGroup) {
+		public.POST("/dismiss", h.DismissAnnouncement)
+	}
+	return section
+}
+
+// excludeDismissed leaves the announcements the caller dismissed out of a find.
+func (h *DiscoverHandler) excludeDismissed(ctx context.Context, c *gin.Context, req *domain.ContentFindReq) error {
+	userID := mcontext.GetOpUserID(c)
+	if userID == "" {
+		return nil
+	}
+
+	ids, err := h.discoverDismissalsUsecase.Find(ctx, domain.ItemTypeAnnouncement, userID)
+	if err != nil {
+		return err
+	}
+	req.ExcludeIDs = ids
+
+	return nil
+}
+
+// DismissAnnouncement Dismiss an announcement for the caller
+//
+// @Summary Dismiss an announcement
+// @Description Hides an announcement from the caller's announcement finds
+// @Tags DiscoverAnnouncements
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverDismissReq true "Dismiss request"
+// @Success 200 {string} string "dismissed"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/announcement/dismiss [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) DismissAnnouncement(c *gin.Context) {
+	var (
+		req domain.DiscoverDismissReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while DismissAnnouncement", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	userID, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	_, err = h.discoverAnnouncementsUsecase.Get(ctx, req.ID, domain.ScheduleAll, "", nil)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = h.discoverDismissalsUsecase.Dismiss(ctx, domain.ItemTypeAnnouncement, userID, req.ID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, "dismissed")
+}
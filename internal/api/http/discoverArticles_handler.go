@@ -1,279 +1,117 @@
 package http
 
 import (
-	"net/http"
+	"context"
 	"strconv"
 	"strings"
 
 	"github.com/gin-gonic/gin"
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
 
 	"github.com/1nterdigital/aka-im-discover/internal/domain"
-	"github.com/1nterdigital/aka-im-tools/apiresp"
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
 	"github.com/1nterdigital/aka-im-tools/errs"
 	"github.com/1nterdigital/aka-im-tools/log"
 	"github.com/1nterdigital/aka-im-tools/mcontext"
-	"github.com/1nterdigital/aka-im-tools/tracer"
 )
 
-// CreateArticle create an article
-//
-// @Summary Create a new article
-// @Description Creates a new article with the given JSON payload
-// @Tags DiscoverArticles
-// @Accept json
-// @Produce json
-// @Param request body domain.DiscoverArticlesAddReq true "Article request"
-// @Success 200 {object} domain.DiscoverArticles "Created article"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /bo/discover/articles/add [post]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) CreateArticle(c *gin.Context) {
-	var (
-		req domain.DiscoverArticlesAddReq
-		err error
-	)
-
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while CreateArticle", err)
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
+// articleSection serves articles. Filtering them by category also returns the
+// category, so the client can render the tab header. An opened article comes with
+// related suggestions and counts towards the trending strip.
+func (h *DiscoverHandler) articleSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverArticlesUsecase)
+	section.findMeta = h.articleCategory
+	section.opened = h.recordArticleOpen
+	section.detail = h.relatedArticles
+	section.routes = func(public, _ *gin.RouterGroup) {
+		public.GET("/trending", h.FindTrendingArticles)
+		public.GET("/:id/go", section.Redirect)
+	}
+	return section
+}
 
-	req.CreatedBy, err = getOperatedByUser(c, req.CreatedBy)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+func (h *DiscoverHandler) articleCategory(
+	ctx context.Context, c *gin.Context, resp gin.H, _ []*entity.DiscoverArticles, locales []string,
+) error {
+	categoryID, err := parseIDParam(c.Query("categoryId"))
+	if err != nil || categoryID == 0 {
+		return err
 	}
 
-	err = c.ShouldBindJSON(&req)
+	category, err := h.discoverCategoriesUsecase.Get(ctx, categoryID)
 	if err != nil {
-		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
+		return err
 	}
-
-	article, err := h.discoverArticlesUsecase.Create(ctx, &req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+	if locales != nil {
+		category.Localize(locales)
 	}
-	apiresp.GinSuccess(c, article)
-}
-
-// FindArticles Get paginated list of articles
-//
-// @Summary Get paginated list of articles
-// @Description Retrieves a paginated list of articles
-// @Tags DiscoverArticles
-// @Accept json
-// @Produce json
-// @Param id query int false "article id" default("0")
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Page size" default(10)
-// @Param title query string false "Title name" default("")
-// @Param sortBy query string false "Sort by" default("position")
-// @Param order query string false "Order by" default("ASC")
-// @Success 200 {array} domain.DiscoverArticles "List of articles"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /bo/discover/articles/find [get]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) FindArticles(c *gin.Context) {
-	var err error
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while FindArticles", err)
-		}
-		span.End()
-	}()
+	resp["category"] = category
 
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
-
-	titleStr := c.DefaultQuery("title", "")
+	return nil
+}
 
-	id, err := parseIDParam(c.DefaultQuery("id", "0"))
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
+// relatedShown is the number of suggestions on the article detail.
+const relatedShown = 5
 
-	page, limit, err := parsePaginationParams(c)
+// relatedArticles fills the suggestions of an article served by the public get. They are
+// secondary, so the article is served without them when they cannot be found.
+func (h *DiscoverHandler) relatedArticles(ctx context.Context, c *gin.Context, item *entity.DiscoverArticles) error {
+	ids, err := h.discoverRelatedUsecase.Related(ctx, item)
 	if err != nil {
-		apiresp.GinError(c, err)
-		return
+		log.ZWarn(ctx, "related articles lookup failed", err, "articleID", item.ID)
+		return nil
 	}
 
-	sortByStr, orderByStr, err := parseSortOrderParams(c)
+	related, err := h.visibleArticles(ctx, ids, relatedShown, h.audience(c))
 	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-
-	req := domain.DiscoverArticlesFindReq{
-		ID:     id,
-		Page:   page,
-		Limit:  limit,
-		Title:  titleStr,
-		SortBy: sortByStr,
-		Order:  orderByStr,
-	}
-
-	if req.Page <= 0 || req.Limit <= 0 {
-		err = errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
+		log.ZWarn(ctx, "related articles lookup failed", err, "articleID", item.ID)
+		return nil
 	}
 
-	articles, total, err := h.discoverArticlesUsecase.Find(ctx, &req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+	locales := h.preferredLocales(c)
+	for _, article := range related {
+		article.Localize(locales)
 	}
+	item.Related = related
 
-	apiresp.GinSuccess(c, gin.H{
-		"total": total,
-		"data":  articles,
-	})
+	return nil
 }
 
-// DeleteArticle Delete an article
-//
-// @Summary Delete an article
-// @Description Deletes an article by its ID
-// @Tags DiscoverArticles
-// @Accept json
-// @Produce json
-// @Param request body domain.DiscoverArticlesDeleteReq true "Delete request"
-// @Success 200 {string} string "deleted"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /discover/articles/delete [delete]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) DeleteArticle(c *gin.Context) {
-	var (
-		req domain.DiscoverArticlesDeleteReq
-		err error
-	)
-
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while DeleteArticle", err)
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
-
-	req.DeletedBy, err = getOperatedByUser(c, req.DeletedBy)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-
-	err = c.ShouldBindJSON(&req)
+// visibleArticles returns up to limit of the articles of ids the caller may see, in
+// the order of ids.
+func (h *DiscoverHandler) visibleArticles(
+	ctx context.Context, ids []int64, limit int, audience *domain.Audience,
+) ([]*entity.DiscoverArticles, error) {
+	resp := make([]*entity.DiscoverArticles, 0, limit)
+	if len(ids) == 0 {
+		return resp, nil
+	}
+
+	found, _, err := h.discoverArticlesUsecase.Find(ctx, &domain.ContentFindReq{
+		Page:     1,
+		Limit:    int32(len(ids)),
+		SortBy:   "position",
+		Order:    "ASC",
+		Schedule: domain.ScheduleLive,
+		Status:   domain.StatusPublished,
+		Audience: audience,
+		Filters:  map[string]string{},
+		IDs:      ids,
+	})
 	if err != nil {
-		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
+		return nil, err
 	}
 
-	if err = h.discoverArticlesUsecase.Delete(ctx, req.ID, req.DeletedBy); err != nil {
-		apiresp.GinError(c, err)
-		return
+	byID := make(map[int64]*entity.DiscoverArticles, len(found))
+	for _, item := range found {
+		byID[item.ID] = item
 	}
-
-	apiresp.GinSuccess(c, "deleted")
-}
-
-// EditArticle Edit an article
-//
-// @Summary Edit an article
-// @Description Updates an existing article
-// @Tags DiscoverArticles
-// @Accept json
-// @Produce json
-// @Param request body domain.DiscoverArticlesEditReq true "Edit request"
-// @Success 200 {string} string "updated article"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid request payload"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /discover/articles/edit [post]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) EditArticle(c *gin.Context) {
-	var (
-		req domain.DiscoverArticlesEditReq
-		err error
-	)
-
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while EditArticle", err)
+	for _, id := range ids {
+		if item, ok := byID[id]; ok && len(resp) < limit {
+			resp = append(resp, item)
 		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
-
-	if req.UpdatedBy == "" {
-		userID := mcontext.GetOpUserID(c)
-		if userID == "" {
-			apiresp.GinError(c, errs.ErrUserIDEmpty.WithDetail("user id not found").Wrap())
-			return
-		}
-		req.UpdatedBy = userID
-	}
-
-	err = c.ShouldBindJSON(&req)
-	if err != nil {
-		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
 	}
 
-	article, err := h.discoverArticlesUsecase.Edit(ctx, &req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-	apiresp.GinSuccess(c, article)
+	return resp, nil
 }
 
 func parsePaginationParams(c *gin.Context) (page, limit int32, err error) {
@@ -345,6 +183,19 @@ func parseIDParam(rawID string) (id int64, err error) {
 	return idInt, nil
 }
 
+func parseRequiredIDParam(rawID string) (id int64, err error) {
+	id, err = parseIDParam(rawID)
+	if err != nil {
+		return 0, err
+	}
+
+	if id == 0 {
+		return 0, errs.ErrArgs.WrapMsg("id query param is required")
+	}
+
+	return id, nil
+}
+
 func getOperatedByUser(c *gin.Context, def string) (operatedBy string, err error) {
 	if def != "" {
 		return def, nil
@@ -358,3 +209,25 @@ func getOperatedByUser(c *gin.Context, def string) (operatedBy string, err error
 
 	return userID, nil
 }
+
+func parseScheduleParam(c *gin.Context) (schedule string, err error) {
+	schedule = strings.ToLower(c.DefaultQuery("schedule", domain.ScheduleAll))
+
+	switch schedule {
+	case "", domain.ScheduleAll:
+		return domain.ScheduleAll, nil
+	case domain.ScheduleLive, domain.ScheduleUpcoming, domain.ScheduleExpired:
+		return schedule, nil
+	default:
+		return "", errs.ErrArgs.WrapMsg("invalid schedule query param: must be all, live, upcoming or expired")
+	}
+}
+
+func parseStatusParam(c *gin.Context) (status string, err error) {
+	status = strings.ToLower(c.Query("status"))
+	if status != "" && !domain.ValidStatus(status) {
+		return "", errs.ErrArgs.WrapMsg("invalid status query param: must be draft, in_review, published or rejected")
+	}
+
+	return status, nil
+}
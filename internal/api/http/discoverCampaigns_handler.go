@@ -0,0 +1,454 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// CreateCampaign create a campaign
+//
+// @Summary Create a new campaign
+// @Description Creates an empty campaign; its window applies to every item added to it later
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignsAddReq true "Campaign request"
+// @Success 200 {object} domain.DiscoverCampaigns "Created campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/add [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) CreateCampaign(c *gin.Context) {
+	var (
+		req domain.DiscoverCampaignsAddReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while CreateCampaign", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.CreatedBy, err = getOperatedByUser(c, req.CreatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	campaign, err := h.discoverCampaignsUsecase.Create(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, campaign)
+}
+
+// FindCampaigns Get paginated list of campaigns
+//
+// @Summary Get campaigns
+// @Description Lists campaigns, newest first, with their items and combined status
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Page size" default(10)
+// @Param name query string false "Campaign name" default("")
+// @Success 200 {array} domain.DiscoverCampaigns "List of campaigns"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/find [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindCampaigns(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindCampaigns", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	page, limit, err := parsePaginationParams(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if page <= 0 || limit <= 0 {
+		err = errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req := domain.DiscoverCampaignsFindReq{
+		Page:  page,
+		Limit: limit,
+		Name:  c.DefaultQuery("name", ""),
+	}
+
+	campaigns, total, err := h.discoverCampaignsUsecase.Find(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"total": total,
+		"data":  campaigns,
+	})
+}
+
+// GetCampaign Get a campaign with its items
+//
+// @Summary Get a campaign
+// @Description Returns a campaign with its items and combined status
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param id query int true "campaign id"
+// @Success 200 {object} domain.DiscoverCampaigns "Campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/get [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) GetCampaign(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while GetCampaign", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseRequiredIDParam(c.Query("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	campaign, err := h.discoverCampaignsUsecase.Get(ctx, id)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, campaign)
+}
+
+// EditCampaign Edit a campaign
+//
+// @Summary Edit a campaign
+// @Description Updates the given fields of a campaign; a new window applies to all of its items at once
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignsEditReq true "Edit request"
+// @Success 200 {object} domain.DiscoverCampaigns "Updated campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/edit [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) EditCampaign(c *gin.Context) {
+	var (
+		req domain.DiscoverCampaignsEditReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while EditCampaign", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.UpdatedBy, err = getOperatedByUser(c, req.UpdatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	campaign, err := h.discoverCampaignsUsecase.Edit(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, campaign)
+}
+
+// DeleteCampaign Delete a campaign
+//
+// @Summary Delete a campaign
+// @Description Deletes a campaign; its items leave it and keep its window as their own
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignsDeleteReq true "Delete request"
+// @Success 200 {string} string "deleted"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/del [delete]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) DeleteCampaign(c *gin.Context) {
+	var (
+		req domain.DiscoverCampaignsDeleteReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while DeleteCampaign", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.DeletedBy, err = getOperatedByUser(c, req.DeletedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if err = h.discoverCampaignsUsecase.Delete(ctx, req.ID, req.DeletedBy); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, "deleted")
+}
+
+// PauseCampaign Pause a campaign
+//
+// @Summary Pause a campaign
+// @Description Hides all items of a campaign at once until it is resumed
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignPauseReq true "Pause request"
+// @Success 200 {object} domain.DiscoverCampaigns "Paused campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/pause [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) PauseCampaign(c *gin.Context) {
+	h.setCampaignPaused(c, true)
+}
+
+// ResumeCampaign Resume a campaign
+//
+// @Summary Resume a campaign
+// @Description Shows all items of a paused campaign again, within the campaign's window
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignPauseReq true "Resume request"
+// @Success 200 {object} domain.DiscoverCampaigns "Resumed campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/resume [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) ResumeCampaign(c *gin.Context) {
+	h.setCampaignPaused(c, false)
+}
+
+func (h *DiscoverHandler) setCampaignPaused(c *gin.Context, paused bool) {
+	var (
+		req domain.DiscoverCampaignPauseReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while setCampaignPaused", err, "paused", paused)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	campaign, err := h.discoverCampaignsUsecase.SetPaused(ctx, &req, paused)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, campaign)
+}
+
+// AddCampaignItems Add items to a campaign
+//
+// @Summary Add items to a campaign
+// @Description Moves carousels and articles into a campaign; their own publish window is cleared so the campaign's applies
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignItemsReq true "Items request"
+// @Success 200 {object} domain.DiscoverCampaigns "Updated campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/items/add [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) AddCampaignItems(c *gin.Context) {
+	h.editCampaignItems(c, false)
+}
+
+// RemoveCampaignItems Remove items from a campaign
+//
+// @Summary Remove items from a campaign
+// @Description Takes items out of a campaign; they keep the campaign's window as their own
+// @Tags DiscoverCampaigns
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCampaignItemsReq true "Items request"
+// @Success 200 {object} domain.DiscoverCampaigns "Updated campaign"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/campaign/items/remove [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) RemoveCampaignItems(c *gin.Context) {
+	h.editCampaignItems(c, true)
+}
+
+func (h *DiscoverHandler) editCampaignItems(c *gin.Context, remove bool) {
+	var (
+		req domain.DiscoverCampaignItemsReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while editCampaignItems", err, "remove", remove)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	campaign, err := h.discoverCampaignsUsecase.EditItems(ctx, &req, remove)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, campaign)
+}
@@ -17,80 +17,36 @@ import (
 	"github.com/1nterdigital/aka-im-tools/tracer"
 )
 
-// CreateCarousel create a carousel
-//
-// @Summary Create a new carousel
-// @Description Creates a new carousel with the given JSON payload
-// @Tags DiscoverCarousels
-// @Accept json
-// @Produce json
-// @Param request body domain.DiscoverCarouselsAddReq true "Carousel request"
-// @Success 200 {object} domain.DiscoverCarousels "Created carousel"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /bo/discover/carousels/add [post]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) CreateCarousel(c *gin.Context) {
-	var (
-		req domain.DiscoverCarouselsAddReq
-		err error
-	)
-
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while CreateCarousel", err)
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
-
-	req.CreatedBy, err = getOperatedByUser(c, req.CreatedBy)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+// carouselSection serves carousel slides; their links are opened through the tracked
+// redirect, and slides running an experiment are shown as the caller's variant.
+func (h *DiscoverHandler) carouselSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverCarouselsUsecase.SectionUseCase)
+	section.present = func(c *gin.Context, item *entity.DiscoverCarousels) string {
+		return item.Present(mcontext.GetOpUserID(c))
 	}
-
-	if err = c.ShouldBindJSON(&req); err != nil {
-		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
-	}
-	carousel, err := h.discoverCarouselsUsecase.Create(ctx, &req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
+	section.routes = func(public, admin *gin.RouterGroup) {
+		public.GET("/:id/go", section.Redirect)
+		admin.GET("/variants", h.FindCarouselVariants)
+		admin.POST("/variants/promote", h.PromoteCarouselVariant)
 	}
-	apiresp.GinSuccess(c, carousel)
+	return section
 }
 
-// FindCarousels Get paginated list of carousels
+// FindCarouselVariants Get the experiment statistics of a carousel
 //
-// @Summary Get paginated list of carousels
-// @Description Retrieves a paginated list of carousels
+// @Summary Get carousel variant statistics
+// @Description Reports the impressions, clicks and CTR of each variant of the running experiment of a carousel,
+// @Description counted from the tracking events reported with the variant since the experiment started
 // @Tags DiscoverCarousels
 // @Accept json
 // @Produce json
-// @Param id query int false "carousel id" default("0")
-// @Param page query int false "Page number" default(1)
-// @Param limit query int false "Page size" default(10)
-// @Param title query string false "Title name" default("")
-// @Param sortBy query string false "Sort by" default("position")
-// @Param order query string false "Order by" default("ASC")
-// @Success 200 {array} domain.DiscoverCarousels "List of carousels"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Param id query int true "carousel id"
+// @Success 200 {array} domain.DiscoverCarouselVariantStats "Variant statistics"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
 // @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /bo/discover/carousels/find [get]
+// @Router /bo/discover/carousel/variants [get]
 // @Security ApiKeyAuth
-func (h *DiscoverHandler) FindCarousels(c *gin.Context) {
+func (h *DiscoverHandler) FindCarouselVariants(c *gin.Context) {
 	var err error
 	ctx, span := otel.Tracer(domain.TracerLevelHandler).
 		Start(c.Request.Context(), tracer.GetFullFunctionPath())
@@ -98,7 +54,7 @@ func (h *DiscoverHandler) FindCarousels(c *gin.Context) {
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while FindCarousels", err)
+			log.ZError(ctx, "an error occurred while FindCarouselVariants", err)
 		}
 		span.End()
 	}()
@@ -109,63 +65,48 @@ func (h *DiscoverHandler) FindCarousels(c *gin.Context) {
 		attribute.String("operationID", mcontext.GetOperationID(c)),
 	)
 
-	titleStr := c.DefaultQuery("title", "")
-
-	id, err := parseIDParam(c.DefaultQuery("id", "0"))
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
-
-	page, limit, err := parsePaginationParams(c)
+	id, err := parseRequiredIDParam(c.Query("id"))
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 
-	sortByStr, orderByStr, err := parseSortOrderParams(c)
+	carousel, err := h.discoverCarouselsUsecase.Get(ctx, id, domain.ScheduleAll, "", nil)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 
-	req := domain.DiscoverCarouselsFindReq{
-		ID:     id,
-		Page:   page,
-		Limit:  limit,
-		Title:  titleStr,
-		SortBy: sortByStr,
-		Order:  orderByStr,
-	}
-
-	carousels, total, err := h.discoverCarouselsUsecase.Find(ctx, &req)
+	stats, err := h.discoverStatsUsecase.VariantStats(ctx, carousel)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 
 	apiresp.GinSuccess(c, gin.H{
-		"total": total,
-		"data":  carousels,
+		"experiment": carousel.Experiment,
+		"data":       stats,
 	})
 }
 
-// DeleteCarousel Delete a carousel
+// PromoteCarouselVariant Promote a variant of a carousel
 //
-// @Summary Delete a carousel
-// @Description Deletes a carousel by its ID
+// @Summary Promote a carousel variant
+// @Description Makes the fields of a variant the slide's own and ends the experiment, as an edit of the carousel;
+// @Description like any edit, a published carousel must be unpublished first and reviewed again.
+// @Description experimentId is the experiment the variant was picked from; the promotion is refused once it changed
 // @Tags DiscoverCarousels
 // @Accept json
 // @Produce json
-// @Param request body domain.DiscoverCarouselsDeleteReq true "Delete request"
-// @Success 200 {string} string "deleted"
+// @Param request body domain.DiscoverCarouselPromoteReq true "Promote request"
+// @Success 200 {object} domain.DiscoverCarousels "Edited carousel"
 // @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
 // @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /discover/carousels/delete [delete]
+// @Router /bo/discover/carousel/variants/promote [post]
 // @Security ApiKeyAuth
-func (h *DiscoverHandler) DeleteCarousel(c *gin.Context) {
+func (h *DiscoverHandler) PromoteCarouselVariant(c *gin.Context) {
 	var (
-		req domain.DiscoverCarouselsDeleteReq
+		req domain.DiscoverCarouselPromoteReq
 		err error
 	)
 
@@ -175,7 +116,7 @@ func (h *DiscoverHandler) DeleteCarousel(c *gin.Context) {
 		if err != nil {
 			span.RecordError(err)
 			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while DeleteCarousel", err)
+			log.ZError(ctx, "an error occurred while PromoteCarouselVariant", err)
 		}
 		span.End()
 	}()
@@ -186,7 +127,7 @@ func (h *DiscoverHandler) DeleteCarousel(c *gin.Context) {
 		attribute.String("operationID", mcontext.GetOperationID(c)),
 	)
 
-	req.DeletedBy, err = getOperatedByUser(c, req.DeletedBy)
+	req.OperatedBy, err = getOperatedByUser(c, "")
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
@@ -199,70 +140,11 @@ func (h *DiscoverHandler) DeleteCarousel(c *gin.Context) {
 		return
 	}
 
-	err = h.discoverCarouselsUsecase.Delete(ctx, req.ID, req.DeletedBy)
+	carousel, err := h.discoverCarouselsUsecase.Promote(ctx, &req)
 	if err != nil {
 		apiresp.GinError(c, err)
 		return
 	}
 
-	apiresp.GinSuccess(c, "deleted")
-}
-
-// EditCarousel Edit a carousel
-//
-// @Summary Edit a carousel
-// @Description Updates an existing carousel
-// @Tags DiscoverCarousels
-// @Accept json
-// @Produce json
-// @Param request body domain.DiscoverCarouselsEditReq true "Edit request"
-// @Success 200 {string} string "updated article"
-// @Failure 400 {object} apiresp.ApiResponse "Invalid request payload"
-// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
-// @Router /discover/carousels/edit [post]
-// @Security ApiKeyAuth
-func (h *DiscoverHandler) EditCarousel(c *gin.Context) {
-	var (
-		req domain.DiscoverCarouselsEditReq
-		err error
-	)
-
-	ctx, span := otel.Tracer(domain.TracerLevelHandler).
-		Start(c.Request.Context(), tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-			log.ZError(ctx, "an error occurred while EditCarousel", err)
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.String("userID", mcontext.GetOpUserID(c)),
-		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
-		attribute.String("operationID", mcontext.GetOperationID(c)),
-	)
-
-	if req.UpdatedBy == "" {
-		userID := mcontext.GetOpUserID(c)
-		if userID == "" {
-			apiresp.GinError(c, errs.ErrUserIDEmpty.WithDetail("user id not found").Wrap())
-			return
-		}
-		req.UpdatedBy = userID
-	}
-	if err = c.ShouldBindJSON(&req); err != nil {
-		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
-		apiresp.GinError(c, err)
-		return
-	}
-
-	var carousel *entity.DiscoverCarousels
-	carousel, err = h.discoverCarouselsUsecase.Edit(ctx, &req)
-	if err != nil {
-		apiresp.GinError(c, err)
-		return
-	}
 	apiresp.GinSuccess(c, carousel)
 }
@@ -0,0 +1,254 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// CreateCategory create an article category
+//
+// @Summary Create a new category
+// @Description Creates an article category used to group articles into tabs
+// @Tags DiscoverCategories
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCategoriesAddReq true "Category request"
+// @Success 200 {object} domain.DiscoverCategories "Created category"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/category/add [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) CreateCategory(c *gin.Context) {
+	var (
+		req domain.DiscoverCategoriesAddReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while CreateCategory", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.CreatedBy, err = getOperatedByUser(c, req.CreatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	category, err := h.discoverCategoriesUsecase.Create(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, category)
+}
+
+// FindCategories Get the article categories shown as tabs
+//
+// @Summary Get article categories
+// @Description Lists categories in display order with the number of live articles in each
+// @Tags DiscoverCategories
+// @Accept json
+// @Produce json
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Success 200 {array} domain.DiscoverCategories "List of categories"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/category/find [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindCategories(c *gin.Context) {
+	h.findCategories(c, true, h.preferredLocales(c))
+}
+
+// AdminFindCategories Get all article categories for the back office
+//
+// @Summary Get article categories
+// @Description Lists categories in display order with the number of non-deleted articles in each
+// @Tags DiscoverCategories
+// @Accept json
+// @Produce json
+// @Success 200 {array} domain.DiscoverCategories "List of categories"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/category/find [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) AdminFindCategories(c *gin.Context) {
+	h.findCategories(c, false, nil)
+}
+
+func (h *DiscoverHandler) findCategories(c *gin.Context, liveOnly bool, locales []string) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while findCategories", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	categories, err := h.discoverCategoriesUsecase.Find(ctx, liveOnly)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if locales != nil {
+		for _, item := range categories {
+			item.Localize(locales)
+		}
+	}
+
+	apiresp.GinSuccess(c, categories)
+}
+
+// EditCategory Edit an article category
+//
+// @Summary Edit a category
+// @Description Updates the given fields of a category
+// @Tags DiscoverCategories
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCategoriesEditReq true "Edit request"
+// @Success 200 {object} domain.DiscoverCategories "Updated category"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/category/edit [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) EditCategory(c *gin.Context) {
+	var (
+		req domain.DiscoverCategoriesEditReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while EditCategory", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.UpdatedBy, err = getOperatedByUser(c, req.UpdatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	category, err := h.discoverCategoriesUsecase.Edit(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, category)
+}
+
+// DeleteCategory Delete an article category
+//
+// @Summary Delete a category
+// @Description Deletes a category that no live article belongs to
+// @Tags DiscoverCategories
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverCategoriesDeleteReq true "Delete request"
+// @Success 200 {string} string "deleted"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/category/del [delete]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) DeleteCategory(c *gin.Context) {
+	var (
+		req domain.DiscoverCategoriesDeleteReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while DeleteCategory", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.DeletedBy, err = getOperatedByUser(c, req.DeletedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if err = h.discoverCategoriesUsecase.Delete(ctx, req.ID, req.DeletedBy); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, "deleted")
+}
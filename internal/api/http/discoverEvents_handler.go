@@ -0,0 +1,228 @@
+package http
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// eventSection serves events. Public finds list the upcoming and ongoing events by
+// start time unless another timeframe is asked for, and tell which ones the caller joined.
+func (h *DiscoverHandler) eventSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverEventsUsecase)
+	section.narrowFind = currentEvents
+	section.findMeta = h.joinedEvents
+	section.routes = func(public, _ *gin.RouterGroup) {
+		public.POST("/rsvp", h.JoinEvent)
+		public.POST("/rsvp/cancel", h.CancelEvent)
+		public.GET("/mine", h.FindMyEvents)
+	}
+	return section
+}
+
+func currentEvents(_ context.Context, _ *gin.Context, req *domain.ContentFindReq) error {
+	if req.Filters["timeframe"] == "" {
+		req.Filters["timeframe"] = domain.TimeframeCurrent
+	}
+	req.SortBy = "starts_at"
+	req.Order = "ASC"
+	return nil
+}
+
+func (h *DiscoverHandler) joinedEvents(
+	ctx context.Context, c *gin.Context, resp gin.H, events []*entity.DiscoverEvents, _ []string,
+) error {
+	userID := mcontext.GetOpUserID(c)
+	if userID == "" {
+		return nil
+	}
+
+	joined, err := h.discoverEventRSVPsUsecase.Joined(ctx, userID, events)
+	if err != nil {
+		return err
+	}
+	resp["joined"] = joined
+
+	return nil
+}
+
+// JoinEvent RSVP to an event
+//
+// @Summary RSVP to an event
+// @Description Takes a seat of a live event for the caller; fails once the event is full or has ended
+// @Tags DiscoverEvents
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverRSVPReq true "RSVP request"
+// @Success 200 {string} string "joined"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/event/rsvp [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) JoinEvent(c *gin.Context) {
+	h.rsvp(c, false)
+}
+
+// CancelEvent Cancel an RSVP
+//
+// @Summary Cancel an RSVP
+// @Description Withdraws the caller's RSVP to an event that has not ended and frees the seat
+// @Tags DiscoverEvents
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverRSVPReq true "RSVP request"
+// @Success 200 {string} string "cancelled"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/event/rsvp/cancel [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) CancelEvent(c *gin.Context) {
+	h.rsvp(c, true)
+}
+
+// rsvp joins a live event, or cancels the RSVP to any event still in the service.
+func (h *DiscoverHandler) rsvp(c *gin.Context, cancel bool) {
+	var (
+		req domain.DiscoverRSVPReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while rsvp", err, "cancel", cancel)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	userID, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	var event *entity.DiscoverEvents
+	if cancel {
+		event, err = h.discoverEventsUsecase.Get(ctx, req.ID, domain.ScheduleAll, "", nil)
+	} else {
+		event, err = h.discoverEventsUsecase.Get(ctx, req.ID, domain.ScheduleLive, domain.StatusPublished, h.audience(c))
+	}
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if cancel {
+		err = h.discoverEventRSVPsUsecase.Cancel(ctx, event, userID)
+	} else {
+		err = h.discoverEventRSVPsUsecase.Join(ctx, event, userID)
+	}
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if cancel {
+		apiresp.GinSuccess(c, "cancelled")
+		return
+	}
+	apiresp.GinSuccess(c, "joined")
+}
+
+// FindMyEvents Get the events the caller joined
+//
+// @Summary Get my events
+// @Description Lists the events the caller has an RSVP for, latest start first
+// @Tags DiscoverEvents
+// @Accept json
+// @Produce json
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Page size" default(10)
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Success 200 {array} domain.DiscoverEvents "List of events"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/event/mine [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindMyEvents(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindMyEvents", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	userID, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	page, limit, err := parsePaginationParams(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if page <= 0 || limit <= 0 {
+		err = errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	events, total, err := h.discoverEventRSVPsUsecase.FindEvents(ctx, userID, page, limit)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	locales := h.preferredLocales(c)
+	for _, event := range events {
+		event.Localize(locales)
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"total": total,
+		"data":  events,
+	})
+}
@@ -0,0 +1,43 @@
+package http
+
+import (
+	"context"
+
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+// featuredGroupSection serves the featured IM groups, completed with their live state
+// from the IM on the public routes.
+func (h *DiscoverHandler) featuredGroupSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverFeaturedGroupsUsecase)
+	section.resolve = h.resolveGroups
+	return section
+}
+
+// resolveGroups fills the IM group of every item, dropping the items whose group is
+// gone, dismissed or private.
+func (h *DiscoverHandler) resolveGroups(
+	ctx context.Context, items []*entity.DiscoverFeaturedGroups,
+) ([]*entity.DiscoverFeaturedGroups, error) {
+	groupIDs := make([]string, 0, len(items))
+	for _, item := range items {
+		groupIDs = append(groupIDs, item.GroupID)
+	}
+
+	groups, err := h.discoverIMGroupsUsecase.Resolve(ctx, groupIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := make([]*entity.DiscoverFeaturedGroups, 0, len(items))
+	for _, item := range items {
+		group, ok := groups[item.GroupID]
+		if !ok {
+			continue
+		}
+		item.Group = group
+		resp = append(resp, item)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,268 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// pageSection is one section of the composed discover page. Content holds what the
+// section find returns; Error is set instead when the section failed.
+type pageSection struct {
+	Section string               `json:"section"`
+	Content gin.H                `json:"content,omitempty"`
+	Error   *apiresp.ApiResponse `json:"error,omitempty"`
+}
+
+// GetPage Get the composed discover page
+//
+// @Summary Get the discover page
+// @Description Returns the live items of every section of the page layout, in layout order.
+// @Description Sections are fetched concurrently; a failing section carries an error instead of content.
+// @Description Without a saved layout every section is returned with 10 items.
+// @Tags DiscoverPage
+// @Accept json
+// @Produce json
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Param X-Platform header string false "Client platform used for targeting"
+// @Param X-App-Version header string false "Client app version used for targeting"
+// @Param X-Region header string false "Client region used for targeting"
+// @Success 200 {array} pageSection "Sections of the page"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/page [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) GetPage(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while GetPage", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	layout, err := h.pageLayout(ctx)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	locales := h.preferredLocales(c)
+	audience := h.audience(c)
+
+	sections := make([]*pageSection, len(layout))
+	var wg sync.WaitGroup
+	for i, row := range layout {
+		section := h.section(row.Section)
+		if section == nil {
+			// The section was removed from the service after the layout was saved.
+			continue
+		}
+
+		wg.Add(1)
+		go func(c *gin.Context) {
+			defer wg.Done()
+			sections[i] = h.composeSection(ctx, c, section, row.ItemLimit, locales, audience)
+		}(c.Copy())
+	}
+	wg.Wait()
+
+	resp := make([]*pageSection, 0, len(sections))
+	for _, section := range sections {
+		if section != nil {
+			resp = append(resp, section)
+		}
+	}
+
+	apiresp.GinSuccess(c, resp)
+}
+
+// composeSection fetches one section of the page, turning an error or a panic into the
+// section error so that the other sections are still served.
+func (h *DiscoverHandler) composeSection(
+	ctx context.Context, c *gin.Context, section sectionRoutes, limit int32,
+	locales []string, audience *domain.Audience,
+) (resp *pageSection) {
+	resp = &pageSection{Section: section.itemType()}
+
+	var err error
+	defer func() {
+		if r := recover(); r != nil {
+			err = errs.ErrInternalServer.WrapMsg(fmt.Sprint("panic: ", r))
+		}
+		if err != nil {
+			log.ZError(ctx, "discover page section failed", err, "section", resp.Section)
+			resp.Content = nil
+			resp.Error = sectionError(err)
+		}
+	}()
+
+	resp.Content, err = section.page(ctx, c, limit, locales, audience)
+	return resp
+}
+
+// pageLayout returns the saved layout, or every section with the default limit in
+// registration order while none is saved.
+func (h *DiscoverHandler) pageLayout(ctx context.Context) ([]*entity.DiscoverPageSections, error) {
+	layout, err := h.discoverPageLayoutUsecase.Find(ctx)
+	if err != nil || len(layout) > 0 {
+		return layout, err
+	}
+
+	layout = make([]*entity.DiscoverPageSections, 0, len(h.sections))
+	for i, section := range h.sections {
+		layout = append(layout, &entity.DiscoverPageSections{
+			Section:   section.itemType(),
+			Position:  i + 1,
+			ItemLimit: domain.DefaultPageSectionLimit,
+		})
+	}
+	return layout, nil
+}
+
+// section returns the registered section of the item type, or nil.
+func (h *DiscoverHandler) section(itemType string) sectionRoutes {
+	for _, section := range h.sections {
+		if section.itemType() == itemType {
+			return section
+		}
+	}
+	return nil
+}
+
+// sectionError renders err the way a failed request would report it.
+func sectionError(err error) *apiresp.ApiResponse {
+	var codeErr errs.CodeError
+	if errors.As(err, &codeErr) {
+		return &apiresp.ApiResponse{ErrCode: codeErr.Code(), ErrMsg: codeErr.Msg(), ErrDlt: codeErr.Detail()}
+	}
+	return &apiresp.ApiResponse{ErrCode: errs.ErrInternalServer.Code(), ErrMsg: errs.ErrInternalServer.Msg()}
+}
+
+// FindPageLayout Get the discover page layout
+//
+// @Summary Get the discover page layout
+// @Description Lists the sections of the discover page in display order with their item limits
+// @Tags DiscoverPage
+// @Accept json
+// @Produce json
+// @Success 200 {array} domain.DiscoverPageSections "Sections of the layout"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/page/layout [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindPageLayout(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindPageLayout", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	layout, err := h.discoverPageLayoutUsecase.Find(ctx)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, layout)
+}
+
+// SavePageLayout Replace the discover page layout
+//
+// @Summary Save the discover page layout
+// @Description Replaces the sections of the discover page; they are shown in the order of the list
+// @Tags DiscoverPage
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverPageLayoutReq true "Layout request"
+// @Success 200 {array} domain.DiscoverPageSections "Saved layout"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/page/layout [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) SavePageLayout(c *gin.Context) {
+	var (
+		req domain.DiscoverPageLayoutReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while SavePageLayout", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.UpdatedBy, err = getOperatedByUser(c, req.UpdatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	for _, section := range req.Sections {
+		if h.section(section.Section) == nil {
+			err = errs.ErrArgs.WrapMsg("unknown section " + section.Section)
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+
+	layout, err := h.discoverPageLayoutUsecase.Save(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, layout)
+}
@@ -0,0 +1,149 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// pollSection serves polls, with the vote and results routes next to the section ones.
+func (h *DiscoverHandler) pollSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverPollsUsecase)
+	section.routes = func(public, _ *gin.RouterGroup) {
+		public.POST("/vote", h.VotePoll)
+		public.GET("/results", h.FindPollResults)
+	}
+	return section
+}
+
+// VotePoll Vote on a poll
+//
+// @Summary Vote on a poll
+// @Description Records the caller's vote on a live, open poll and returns the results.
+// @Description A user votes once per poll; single choice polls take exactly one option.
+// @Tags DiscoverPolls
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverPollVoteReq true "Vote request"
+// @Success 200 {object} domain.DiscoverPollResults "Results of the poll"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/poll/vote [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) VotePoll(c *gin.Context) {
+	var (
+		req domain.DiscoverPollVoteReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while VotePoll", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	userID, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	poll, err := h.discoverPollsUsecase.Get(ctx, req.ID, domain.ScheduleLive, domain.StatusPublished, h.audience(c))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	results, err := h.discoverPollVotesUsecase.Vote(ctx, poll, userID, req.OptionIDs)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, results)
+}
+
+// FindPollResults Get the results of a poll
+//
+// @Summary Get poll results
+// @Description Returns the options of a live poll; the vote counts are only included once the caller has voted
+// @Tags DiscoverPolls
+// @Accept json
+// @Produce json
+// @Param id query int true "poll id"
+// @Success 200 {object} domain.DiscoverPollResults "Results of the poll"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/poll/results [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindPollResults(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindPollResults", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	userID, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	id, err := parseRequiredIDParam(c.Query("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	poll, err := h.discoverPollsUsecase.Get(ctx, id, domain.ScheduleLive, domain.StatusPublished, h.audience(c))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	results, err := h.discoverPollVotesUsecase.Results(ctx, poll, userID)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, results)
+}
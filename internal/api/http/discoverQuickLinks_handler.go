@@ -0,0 +1,42 @@
+package http
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+// quickLinkGroup is the links of one group of the quick link grid.
+type quickLinkGroup struct {
+	Group string                       `json:"group"`
+	Items []*entity.DiscoverQuickLinks `json:"items"`
+}
+
+// quickLinkSection serves the quick links. Next to the flat page, a find returns the
+// links grouped by group, groups in the order of their first link.
+func (h *DiscoverHandler) quickLinkSection() sectionRoutes {
+	section := newSectionHandler(h, h.discoverQuickLinksUsecase)
+	section.findMeta = groupQuickLinks
+	return section
+}
+
+func groupQuickLinks(
+	_ context.Context, _ *gin.Context, resp gin.H, links []*entity.DiscoverQuickLinks, _ []string,
+) error {
+	groups := []*quickLinkGroup{}
+	byName := map[string]*quickLinkGroup{}
+	for _, link := range links {
+		group, ok := byName[link.GroupName]
+		if !ok {
+			group = &quickLinkGroup{Group: link.GroupName}
+			byName[link.GroupName] = group
+			groups = append(groups, group)
+		}
+		group.Items = append(group.Items, link)
+	}
+	resp["groups"] = groups
+
+	return nil
+}
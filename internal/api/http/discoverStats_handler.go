@@ -0,0 +1,162 @@
+package http
+
+import (
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// statsMetrics lists the metrics accepted by the by query param.
+var statsMetrics = []string{
+	domain.StatsByImpressions, domain.StatsByClicks, domain.StatsByUniqueUsers, domain.StatsByCTR, domain.StatsByViewers,
+}
+
+// FindItemStats Get the engagement statistics of discover items
+//
+// @Summary Get item statistics
+// @Description Lists the daily impressions, clicks, unique users, CTR and approximate viewers of the items, latest day
+// @Description first. With top, ranks the items by a metric over the range instead; their unique users and viewers
+// @Description count a user once over the range. Viewers of a range are only known, and ranked by, over the last 8 days.
+// @Description Statistics are rolled up from the tracking events periodically and lag behind them, except the viewers
+// @Description of the last 8 days and the unique users of a range, which are read live
+// @Tags DiscoverStats
+// @Accept json
+// @Produce json
+// @Param from query string false "First UTC day, YYYY-MM-DD; defaults to 6 days before to"
+// @Param to query string false "Last UTC day, YYYY-MM-DD; defaults to today"
+// @Param itemType query string false "Item type" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param itemId query int false "Item id"
+// @Param page query int false "Page number, ignored with top" default(1)
+// @Param limit query int false "Page size, ignored with top" default(10)
+// @Param top query int false "Number of items to rank, at most 100"
+// @Param by query string false "Metric ranked by with top" Enums(impressions, clicks, uniqueUsers, ctr, viewers) default(impressions)
+// @Success 200 {array} domain.DiscoverItemStats "Item statistics"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid filters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/stats [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindItemStats(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindItemStats", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req, err := parseItemStatsReq(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	var (
+		stats []domain.DiscoverItemStats
+		total int64
+	)
+	if req.Top > 0 {
+		stats, err = h.discoverStatsUsecase.Top(ctx, req)
+		total = int64(len(stats))
+	} else {
+		stats, total, err = h.discoverStatsUsecase.Find(ctx, req)
+	}
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"total": total,
+		"data":  stats,
+	})
+}
+
+func parseItemStatsReq(c *gin.Context) (req *domain.DiscoverItemStatsFindReq, err error) {
+	req = &domain.DiscoverItemStatsFindReq{
+		ItemType: strings.ToLower(c.Query("itemType")),
+		By:       c.DefaultQuery("by", domain.StatsByImpressions),
+	}
+
+	req.To, err = parseStatsDay(c.Query("to"), time.Now().UTC(), "to")
+	if err != nil {
+		return nil, err
+	}
+	req.From, err = parseStatsDay(c.Query("from"), req.To.AddDate(0, 0, 1-domain.DefaultStatsRangeDays), "from")
+	if err != nil {
+		return nil, err
+	}
+	if req.From.After(req.To) || req.To.Sub(req.From) >= domain.MaxStatsRangeDays*24*time.Hour {
+		return nil, errs.ErrArgs.WrapMsg("invalid from and to query params: must span 1 to " +
+			strconv.Itoa(domain.MaxStatsRangeDays) + " days")
+	}
+
+	if req.ItemType != "" && !slices.Contains(domain.TrackingItemTypes, req.ItemType) {
+		return nil, errs.ErrArgs.WrapMsg("invalid itemType query param: must be one of " +
+			strings.Join(domain.TrackingItemTypes, ", "))
+	}
+
+	req.ItemID, err = parseIDParam(c.Query("itemId"))
+	if err != nil {
+		return nil, err
+	}
+
+	top := c.Query("top")
+	if top != "" {
+		req.Top, err = strconv.Atoi(top)
+		if err != nil || req.Top <= 0 || req.Top > domain.MaxStatsTopLimit {
+			return nil, errs.ErrArgs.WrapMsg("invalid top query param: must be between 1 and " +
+				strconv.Itoa(domain.MaxStatsTopLimit))
+		}
+		if !slices.Contains(statsMetrics, req.By) {
+			return nil, errs.ErrArgs.WrapMsg("invalid by query param: must be one of " + strings.Join(statsMetrics, ", "))
+		}
+		return req, nil
+	}
+
+	req.Page, req.Limit, err = parsePaginationParams(c)
+	if err != nil {
+		return nil, err
+	}
+	if req.Page <= 0 || req.Limit <= 0 {
+		return nil, errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
+	}
+
+	return req, nil
+}
+
+// parseStatsDay parses a YYYY-MM-DD query param as a UTC day, falling back to the day of def.
+func parseStatsDay(raw string, def time.Time, name string) (day time.Time, err error) {
+	if raw == "" {
+		return def.Truncate(24 * time.Hour), nil
+	}
+
+	day, err = time.Parse(domain.StatsDayLayout, raw)
+	if err != nil {
+		return time.Time{}, errs.ErrArgs.WrapMsg("invalid " + name + " query param: must be YYYY-MM-DD")
+	}
+	return day, nil
+}
@@ -0,0 +1,102 @@
+package http
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const maxDryRunLimit = 100
+
+// DryRunTargeting Preview the discover content a given audience would see
+//
+// @Summary Dry run targeting rules
+// @Description Returns the live articles and carousels that the given platform, app version, region and user type would see
+// @Tags DiscoverTargeting
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverDryRunReq true "Audience to preview"
+// @Success 200 {object} map[string]interface{} "Visible articles and carousels"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/targeting/dry-run [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) DryRunTargeting(c *gin.Context) {
+	var (
+		req domain.DiscoverDryRunReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while DryRunTargeting", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if req.Limit <= 0 || req.Limit > maxDryRunLimit {
+		req.Limit = maxDryRunLimit
+	}
+
+	articles, articlesTotal, err := h.discoverArticlesUsecase.Find(ctx, &domain.ContentFindReq{
+		Page:     1,
+		Limit:    req.Limit,
+		SortBy:   "position",
+		Order:    "ASC",
+		Schedule: domain.ScheduleLive,
+		Status:   domain.StatusPublished,
+		Audience: &req.Audience,
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	carousels, carouselsTotal, err := h.discoverCarouselsUsecase.Find(ctx, &domain.ContentFindReq{
+		Page:     1,
+		Limit:    req.Limit,
+		SortBy:   "position",
+		Order:    "ASC",
+		Schedule: domain.ScheduleLive,
+		Status:   domain.StatusPublished,
+		Audience: &req.Audience,
+	})
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"articles":       articles,
+		"articlesTotal":  articlesTotal,
+		"carousels":      carousels,
+		"carouselsTotal": carouselsTotal,
+	})
+}
@@ -0,0 +1,82 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// TrackEvents Report impressions and clicks of discover items
+//
+// @Summary Report impressions and clicks
+// @Description Queues a batch of up to 100 impression and click events. A batch is accepted or refused as a whole;
+// @Description when too many events are waiting to be stored, it is refused with 429 and should be retried later
+// @Tags DiscoverTracking
+// @Accept json
+// @Produce json
+// @Param request body domain.DiscoverTrackingReq true "Tracking events"
+// @Success 200 {string} string "accepted"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 429 {object} apiresp.ApiResponse "Too many events queued, retry later"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/events [post]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) TrackEvents(c *gin.Context) {
+	var (
+		req domain.DiscoverTrackingReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while TrackEvents", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.UserID = mcontext.GetOpUserID(c)
+	req.Platform = h.audience(c).Platform
+	req.OperationID = mcontext.GetOperationID(c)
+
+	err = h.discoverTrackingUsecase.Track(ctx, &req)
+	if err != nil {
+		var codeErr errs.CodeError
+		if errors.As(err, &codeErr) && codeErr.Code() == eerrs.ErrorCodeTrackingBusy {
+			c.AbortWithStatusJSON(http.StatusTooManyRequests, sectionError(err))
+			return
+		}
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, "accepted")
+}
@@ -0,0 +1,158 @@
+package http
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// trendingScan is how many ranked articles are read to fill the strip, leaving room
+// for the ones the caller cannot see.
+const trendingScan = 100
+
+// FindTrendingArticles Get the trending articles
+//
+// @Summary Get trending articles
+// @Description Lists the live articles opened the most in the window, recent opens weighing more. When too few
+// @Description articles were opened, the strip is completed in position order; ranked tells how many came from the ranking
+// @Tags DiscoverArticles
+// @Accept json
+// @Produce json
+// @Param window query int false "Window in hours, one of the configured windows; defaults to the first one"
+// @Param limit query int false "Number of articles, at most 50" default(10)
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Param X-Platform header string false "Client platform used for targeting"
+// @Param X-App-Version header string false "Client app version used for targeting"
+// @Param X-Region header string false "Client region used for targeting"
+// @Success 200 {array} domain.DiscoverArticles "Trending articles"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid window or limit"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/article/trending [get]
+// @Security ApiKeyAuth
+func (h *DiscoverHandler) FindTrendingArticles(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindTrendingArticles", err)
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	window, err := h.parseTrendingWindow(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(domain.DefaultTrendingLimit)))
+	if err != nil || limit <= 0 || limit > domain.MaxTrendingLimit {
+		err = errs.ErrArgs.WrapMsg("invalid limit query param: must be between 1 and " +
+			strconv.Itoa(domain.MaxTrendingLimit))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	items, ranked, err := h.trendingArticles(ctx, window, limit, h.audience(c))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	locales := h.preferredLocales(c)
+	for _, item := range items {
+		item.Localize(locales)
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"window": window,
+		"ranked": ranked,
+		"data":   items,
+	})
+}
+
+func (h *DiscoverHandler) parseTrendingWindow(c *gin.Context) (window int, err error) {
+	raw := c.Query("window")
+	if raw == "" {
+		return h.trendingWindows[0], nil
+	}
+
+	window, err = strconv.Atoi(raw)
+	if err != nil || !slices.Contains(h.trendingWindows, window) {
+		return 0, errs.ErrArgs.WrapMsg("invalid window query param: not a configured trending window")
+	}
+	return window, nil
+}
+
+// trendingArticles returns the visible articles of the ranking of window, best first,
+// completed in position order up to limit. ranked is the number taken from the ranking.
+func (h *DiscoverHandler) trendingArticles(
+	ctx context.Context, window, limit int, audience *domain.Audience,
+) (resp []*entity.DiscoverArticles, ranked int, err error) {
+	ids, err := h.discoverTrendingUsecase.Ranking(ctx, window, trendingScan)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err = h.visibleArticles(ctx, ids, limit, audience)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	ranked = len(resp)
+	if ranked == limit {
+		return resp, ranked, nil
+	}
+
+	exclude := make([]int64, 0, ranked)
+	for _, item := range resp {
+		exclude = append(exclude, item.ID)
+	}
+
+	fill, _, err := h.discoverArticlesUsecase.Find(ctx, &domain.ContentFindReq{
+		Page:       1,
+		Limit:      int32(limit - ranked),
+		SortBy:     "position",
+		Order:      "ASC",
+		Schedule:   domain.ScheduleLive,
+		Status:     domain.StatusPublished,
+		Audience:   audience,
+		Filters:    map[string]string{},
+		ExcludeIDs: exclude,
+	})
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return append(resp, fill...), ranked, nil
+}
+
+func (h *DiscoverHandler) recordArticleOpen(ctx context.Context, item *entity.DiscoverArticles) {
+	err := h.discoverTrendingUsecase.RecordOpen(ctx, item.ID)
+	if err != nil {
+		log.ZWarn(ctx, "failed to record article open", err, "articleID", item.ID)
+	}
+}
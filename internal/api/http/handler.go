@@ -1,20 +1,113 @@
 package http
 
 import (
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	"github.com/1nterdigital/aka-im-discover/internal/service"
 	"github.com/1nterdigital/aka-im-discover/internal/usecase"
+	"github.com/1nterdigital/aka-im-discover/pkg/common/constant"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
 )
 
 type DiscoverHandler struct {
-	healthUsecase            *usecase.HealthUseCase
-	discoverArticlesUsecase  *usecase.DiscoverArticlesUseCase
-	discoverCarouselsUsecase *usecase.DiscoverCarouselsUseCase
+	healthUsecase                 *usecase.HealthUseCase
+	discoverArticlesUsecase       *usecase.DiscoverArticlesUseCase
+	discoverCarouselsUsecase      *usecase.DiscoverCarouselsUseCase
+	discoverCategoriesUsecase     *usecase.DiscoverCategoriesUseCase
+	discoverAnnouncementsUsecase  *usecase.DiscoverAnnouncementsUseCase
+	discoverDismissalsUsecase     *usecase.DiscoverDismissalsUseCase
+	discoverQuickLinksUsecase     *usecase.DiscoverQuickLinksUseCase
+	discoverPageLayoutUsecase     *usecase.DiscoverPageLayoutUseCase
+	discoverPollsUsecase          *usecase.DiscoverPollsUseCase
+	discoverPollVotesUsecase      *usecase.DiscoverPollVotesUseCase
+	discoverEventsUsecase         *usecase.DiscoverEventsUseCase
+	discoverEventRSVPsUsecase     *usecase.DiscoverEventRSVPsUseCase
+	discoverFeaturedGroupsUsecase *usecase.DiscoverFeaturedGroupsUseCase
+	discoverIMGroupsUsecase       *usecase.DiscoverIMGroupsUseCase
+	discoverCampaignsUsecase      *usecase.DiscoverCampaignsUseCase
+	discoverTrendingUsecase       *usecase.DiscoverTrendingUseCase
+	discoverRelatedUsecase        *usecase.DiscoverRelatedArticlesUseCase
+	discoverTrackingUsecase       *usecase.DiscoverTrackingUseCase
+	discoverStatsUsecase          *usecase.DiscoverStatsUseCase
+	defaultLocale                 string
+	trendingWindows               []int
+	linkPolicy                    domain.LinkPolicy
+	sections                      []sectionRoutes
 }
 
 func NewDiscoverHandler(u *service.Api) *DiscoverHandler {
-	return &DiscoverHandler{
-		healthUsecase:            u.HealthUseCase().Health,
-		discoverArticlesUsecase:  u.DiscoverUseCase().DiscoverArticles,
-		discoverCarouselsUsecase: u.DiscoverUseCase().DiscoverCarousels,
+	h := &DiscoverHandler{
+		healthUsecase:                 u.HealthUseCase().Health,
+		discoverArticlesUsecase:       u.DiscoverUseCase().DiscoverArticles,
+		discoverCarouselsUsecase:      u.DiscoverUseCase().DiscoverCarousels,
+		discoverCategoriesUsecase:     u.DiscoverUseCase().DiscoverCategories,
+		discoverAnnouncementsUsecase:  u.DiscoverUseCase().DiscoverAnnouncements,
+		discoverDismissalsUsecase:     u.DiscoverUseCase().DiscoverDismissals,
+		discoverQuickLinksUsecase:     u.DiscoverUseCase().DiscoverQuickLinks,
+		discoverPageLayoutUsecase:     u.DiscoverUseCase().DiscoverPageLayout,
+		discoverPollsUsecase:          u.DiscoverUseCase().DiscoverPolls,
+		discoverPollVotesUsecase:      u.DiscoverUseCase().DiscoverPollVotes,
+		discoverEventsUsecase:         u.DiscoverUseCase().DiscoverEvents,
+		discoverEventRSVPsUsecase:     u.DiscoverUseCase().DiscoverEventRSVPs,
+		discoverFeaturedGroupsUsecase: u.DiscoverUseCase().DiscoverFeaturedGroups,
+		discoverIMGroupsUsecase:       u.DiscoverUseCase().DiscoverIMGroups,
+		discoverCampaignsUsecase:      u.DiscoverUseCase().DiscoverCampaigns,
+		discoverTrendingUsecase:       u.DiscoverUseCase().DiscoverTrending,
+		discoverRelatedUsecase:        u.DiscoverUseCase().DiscoverRelated,
+		discoverTrackingUsecase:       u.DiscoverUseCase().DiscoverTracking,
+		discoverStatsUsecase:          u.DiscoverUseCase().DiscoverStats,
+		defaultLocale:                 u.DefaultLocale,
+		trendingWindows:               u.TrendingWindows,
+		linkPolicy:                    u.LinkPolicy,
 	}
+
+	h.sections = []sectionRoutes{
+		h.articleSection(),
+		h.carouselSection(),
+		h.announcementSection(),
+		h.quickLinkSection(),
+		h.pollSection(),
+		h.eventSection(),
+		h.featuredGroupSection(),
+	}
+
+	return h
+}
+
+// RegisterSections mounts the routes of every discover section, each under its own
+// path in the public and the back office groups.
+func (h *DiscoverHandler) RegisterSections(public, admin *gin.RouterGroup) {
+	for _, section := range h.sections {
+		section.register(public, admin)
+	}
+}
+
+// preferredLocales resolves the locales a public response should be rendered in.
+func (h *DiscoverHandler) preferredLocales(c *gin.Context) []string {
+	return domain.PreferredLocales(c.Query("lang"), c.GetHeader("Accept-Language"), h.defaultLocale)
+}
+
+// audience describes the caller for targeting: platform, app version and region come
+// from the client headers, the user type from the token parsed by the middleware.
+func (h *DiscoverHandler) audience(c *gin.Context) *domain.Audience {
+	audience := &domain.Audience{
+		Platform:   c.GetHeader(constant.HeaderPlatform),
+		AppVersion: c.GetHeader(constant.HeaderAppVersion),
+		Region:     c.GetHeader(constant.HeaderRegion),
+	}
+	if audience.Platform == "" {
+		audience.Platform = mcontext.GetOpUserPlatform(c)
+	}
+
+	if userTypes := c.GetStringSlice(constant.RpcOpUserType); len(userTypes) > 0 {
+		userType, err := strconv.ParseInt(userTypes[0], 10, 32)
+		if err == nil {
+			audience.UserType = int32(userType)
+		}
+	}
+
+	return audience
 }
@@ -0,0 +1,1024 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	entity "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/usecase"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/apiresp"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// sectionRoutes is a discover section served under /discover/{section} and /bo/discover/{section}.
+type sectionRoutes interface {
+	itemType() string
+	register(public, admin *gin.RouterGroup)
+	page(
+		ctx context.Context, c *gin.Context, limit int32, locales []string, audience *domain.Audience,
+	) (gin.H, error)
+}
+
+// sectionHandler serves the public and back office routes of one discover section.
+type sectionHandler[T any, P entity.ContentPtr[T], A any, E any] struct {
+	h  *DiscoverHandler
+	uc *usecase.SectionUseCase[T, P, A, E]
+	// findMeta, when set, adds section-specific metadata about the found items to a find response.
+	findMeta func(ctx context.Context, c *gin.Context, resp gin.H, items []P, locales []string) error
+	// narrowFind, when set, adjusts a public find to what and how the caller should see.
+	narrowFind func(ctx context.Context, c *gin.Context, req *domain.ContentFindReq) error
+	// resolve, when set, completes the items of a public find or get with live data from
+	// outside the section and drops the ones that must not be shown anymore.
+	resolve func(ctx context.Context, items []P) ([]P, error)
+	// detail, when set, completes the item served by the public get.
+	detail func(ctx context.Context, c *gin.Context, item P) error
+	// opened, when set, is told about every item served by the public get.
+	opened func(ctx context.Context, item P)
+	// present, when set, shows a localized item of a public route as the caller should
+	// see it and returns the experiment variant it was served as, if any.
+	present func(c *gin.Context, item P) string
+	// routes, when set, mounts section-specific routes on the public and back office groups.
+	routes func(public, admin *gin.RouterGroup)
+}
+
+func newSectionHandler[T any, P entity.ContentPtr[T], A any, E any](
+	h *DiscoverHandler, uc *usecase.SectionUseCase[T, P, A, E],
+) *sectionHandler[T, P, A, E] {
+	return &sectionHandler[T, P, A, E]{h: h, uc: uc}
+}
+
+func (s *sectionHandler[T, P, A, E]) itemType() string {
+	return s.uc.ItemType()
+}
+
+func (s *sectionHandler[T, P, A, E]) register(public, admin *gin.RouterGroup) {
+	path := "/" + s.uc.ItemType()
+
+	group := public.Group(path)
+	group.GET("/find", s.Find)
+	group.GET("/:id", s.Get)
+
+	adminGroup := admin.Group(path)
+	adminGroup.GET("/find", s.AdminFind)
+	adminGroup.POST("/add", s.Create)
+	adminGroup.DELETE("/del", s.Delete)
+	adminGroup.POST("/edit", s.Edit)
+	adminGroup.POST("/status", s.Transition)
+	adminGroup.GET("/status/logs", s.FindStatusLogs)
+	adminGroup.GET("/history", s.FindHistory)
+	adminGroup.POST("/rollback", s.Rollback)
+	adminGroup.GET("/trash", s.FindTrash)
+	adminGroup.POST("/restore", s.Restore)
+	adminGroup.POST("/reorder", s.Reorder)
+
+	if s.routes != nil {
+		s.routes(group, adminGroup)
+	}
+}
+
+// Create create a section item
+//
+// @Summary Create a new item
+// @Description Creates a new draft item of the section with the given JSON payload
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverArticlesAddReq true "Add request of the section"
+// @Success 200 {object} domain.DiscoverArticles "Created item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/add [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Create(c *gin.Context) {
+	var (
+		req A
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Create", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	createdBy, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Create(ctx, &req, createdBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// Find Get paginated list of live section items
+//
+// @Summary Get paginated list of live items
+// @Description Retrieves published items of the section inside their publish window and targeted at the caller.
+// @Description Articles also accept the categoryId and tag filters and return the category metadata.
+// @Description Announcements accept the severity filter and leave out the ones the caller dismissed.
+// @Description Quick links accept the group filter and are also returned grouped by group.
+// @Description Events accept the timeframe filter, default current, are sorted by start time and list the joined ones.
+// @Description Groups carry the live name, avatar and member count from the IM and leave out dismissed or private groups.
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param id query int false "item id" default("0")
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Page size" default(10)
+// @Param title query string false "Title name" default("")
+// @Param sortBy query string false "Sort by" default("position")
+// @Param order query string false "Order by" default("ASC")
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Param X-Platform header string false "Client platform used for targeting"
+// @Param X-App-Version header string false "Client app version used for targeting"
+// @Param X-Region header string false "Client region used for targeting"
+// @Success 200 {array} domain.DiscoverArticles "List of items"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/{section}/find [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Find(c *gin.Context) {
+	s.find(c, domain.ScheduleLive, domain.StatusPublished, s.h.preferredLocales(c), s.h.audience(c))
+}
+
+// AdminFind Get paginated list of section items for the back office
+//
+// @Summary Get paginated list of items
+// @Description Retrieves a paginated list of items of the section, optionally filtered by schedule and status
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param id query int false "item id" default("0")
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Page size" default(10)
+// @Param title query string false "Title name" default("")
+// @Param sortBy query string false "Sort by" default("position")
+// @Param order query string false "Order by" default("ASC")
+// @Param schedule query string false "Schedule: all, live, upcoming or expired" default("all")
+// @Param status query string false "Status: draft, in_review, published or rejected" default("")
+// @Success 200 {array} domain.DiscoverArticles "List of items"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/find [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) AdminFind(c *gin.Context) {
+	schedule, err := parseScheduleParam(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	status, err := parseStatusParam(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	s.find(c, schedule, status, nil, nil)
+}
+
+// find lists items; when locales is set each item is rendered in the best matching locale,
+// and when audience is set items targeted at someone else are left out.
+func (s *sectionHandler[T, P, A, E]) find(
+	c *gin.Context, schedule, status string, locales []string, audience *domain.Audience,
+) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while find", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseIDParam(c.DefaultQuery("id", "0"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	page, limit, err := parsePaginationParams(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if page <= 0 || limit <= 0 {
+		err = errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	sortByStr, orderByStr, err := parseSortOrderParams(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	filters := map[string]string{}
+	for _, param := range s.uc.FilterParams() {
+		if value := strings.TrimSpace(c.Query(param)); value != "" {
+			filters[param] = value
+		}
+	}
+
+	req := domain.ContentFindReq{
+		ID:       id,
+		Page:     page,
+		Limit:    limit,
+		Title:    c.DefaultQuery("title", ""),
+		SortBy:   sortByStr,
+		Order:    orderByStr,
+		Schedule: schedule,
+		Status:   status,
+		Audience: audience,
+		Filters:  filters,
+	}
+
+	resp, err := s.list(ctx, c, &req, locales)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, resp)
+}
+
+// page lists the first live items of the section for the composed discover page.
+func (s *sectionHandler[T, P, A, E]) page(
+	ctx context.Context, c *gin.Context, limit int32, locales []string, audience *domain.Audience,
+) (gin.H, error) {
+	req := domain.ContentFindReq{
+		Page:     1,
+		Limit:    limit,
+		SortBy:   "position",
+		Order:    "ASC",
+		Schedule: domain.ScheduleLive,
+		Status:   domain.StatusPublished,
+		Audience: audience,
+		Filters:  map[string]string{},
+	}
+
+	return s.list(ctx, c, &req, locales)
+}
+
+// list runs a find and returns the response body with the section metadata.
+func (s *sectionHandler[T, P, A, E]) list(
+	ctx context.Context, c *gin.Context, req *domain.ContentFindReq, locales []string,
+) (resp gin.H, err error) {
+	if req.Audience != nil && s.narrowFind != nil {
+		err = s.narrowFind(ctx, c, req)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	items, total, err := s.uc.Find(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Audience != nil && s.resolve != nil {
+		resolved, resolveErr := s.resolve(ctx, items)
+		if resolveErr != nil {
+			return nil, resolveErr
+		}
+		total -= int64(len(items) - len(resolved))
+		items = resolved
+	}
+
+	resp = gin.H{
+		"total": total,
+		"data":  items,
+	}
+
+	if s.findMeta != nil {
+		err = s.findMeta(ctx, c, resp, items, locales)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if locales != nil {
+		for _, item := range items {
+			item.Localize(locales)
+			if s.present != nil {
+				s.present(c, item)
+			}
+		}
+	}
+
+	return resp, nil
+}
+
+// Get Get a single live section item
+//
+// @Summary Get an item by ID
+// @Description Returns a published item of the section inside its publish window, or an ItemNotFound error
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param id path int true "item id"
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Param X-Platform header string false "Client platform used for targeting"
+// @Param X-App-Version header string false "Client app version used for targeting"
+// @Param X-Region header string false "Client region used for targeting"
+// @Success 200 {object} domain.DiscoverArticles "Item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/{section}/{id} [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Get(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Get", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseRequiredIDParam(c.Param("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Get(ctx, id, domain.ScheduleLive, domain.StatusPublished, s.h.audience(c))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if s.resolve != nil {
+		var resolved []P
+		resolved, err = s.resolve(ctx, []P{item})
+		if err == nil && len(resolved) == 0 {
+			err = eerrs.ErrItemNotFound.WrapMsg(s.uc.ItemType() + " not found")
+		}
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	if s.detail != nil {
+		err = s.detail(ctx, c, item)
+		if err != nil {
+			apiresp.GinError(c, err)
+			return
+		}
+	}
+	if s.opened != nil {
+		s.opened(ctx, item)
+	}
+	item.Localize(s.h.preferredLocales(c))
+	if s.present != nil {
+		s.present(c, item)
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// Redirect Open the link of a section item
+//
+// @Summary Open the link of an item
+// @Description Records a click on a live item and redirects to its link, localized like the item and taken from the
+// @Description caller's variant for a carousel running an experiment. Items that are not live, and links the link
+// @Description policy does not allow, are refused
+// @Tags DiscoverSections
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel)
+// @Param id path int true "item id"
+// @Param lang query string false "Preferred locale, overrides Accept-Language"
+// @Param Accept-Language header string false "Preferred locales"
+// @Success 302 {string} string "Redirect to the item's link"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /discover/{section}/{id}/go [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Redirect(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Redirect", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseRequiredIDParam(c.Param("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	audience := s.h.audience(c)
+	item, err := s.uc.Get(ctx, id, domain.ScheduleLive, domain.StatusPublished, audience)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	item.Localize(s.h.preferredLocales(c))
+	var variant string
+	if s.present != nil {
+		variant = s.present(c, item)
+	}
+
+	link := item.Base().LinkURL
+	if !s.h.linkPolicy.Allows(link) {
+		err = eerrs.ErrLinkNotAllowed.WrapMsg(s.uc.ItemType() + " link is not allowed")
+		apiresp.GinError(c, err)
+		return
+	}
+
+	// A lost click must not keep the user from the link.
+	trackErr := s.h.discoverTrackingUsecase.Track(ctx, &domain.DiscoverTrackingReq{
+		Events: []domain.DiscoverTrackingEvent{
+			{ItemType: s.uc.ItemType(), ItemID: id, Kind: domain.TrackingClick, Variant: variant},
+		},
+		UserID:      mcontext.GetOpUserID(c),
+		Platform:    audience.Platform,
+		OperationID: mcontext.GetOperationID(c),
+	})
+	if trackErr != nil {
+		log.ZWarn(ctx, "failed to record click", trackErr, "itemType", s.uc.ItemType(), "id", id)
+	}
+
+	c.Redirect(http.StatusFound, link)
+}
+
+// Delete Delete a section item
+//
+// @Summary Delete an item
+// @Description Moves an item of the section to the trash
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.ContentDeleteReq true "Delete request"
+// @Success 200 {string} string "deleted"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/del [delete]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Delete(c *gin.Context) {
+	var (
+		req domain.ContentDeleteReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Delete", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	req.DeletedBy, err = getOperatedByUser(c, req.DeletedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if err = s.uc.Delete(ctx, req.ID, req.DeletedBy); err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, "deleted")
+}
+
+// Edit Edit a section item
+//
+// @Summary Edit an item
+// @Description Updates an existing draft or rejected item of the section; omitted fields are left unchanged.
+// @Description Items in review must be withdrawn and published items unpublished before they are edited
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverArticlesEditReq true "Edit request of the section"
+// @Success 200 {object} domain.DiscoverArticles "Updated item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid request payload"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/edit [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Edit(c *gin.Context) {
+	var (
+		req E
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Edit", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	updatedBy, err := getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Edit(ctx, &req, updatedBy)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// Transition Move a section item through the editorial workflow
+//
+// @Summary Change the status of an item
+// @Description Applies a workflow action (submit, withdraw, approve, reject or unpublish) to an item.
+// @Description Approve and reject must be done by another admin than the one who submitted the item.
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverStatusReq true "Status request"
+// @Success 200 {object} domain.DiscoverArticles "Updated item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/status [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Transition(c *gin.Context) {
+	var (
+		req domain.DiscoverStatusReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Transition", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Transition(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// FindStatusLogs Get the workflow history of a section item
+//
+// @Summary Get the status history of an item
+// @Description Lists every status transition of an item with the admin who made it, newest first
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param id query int true "item id"
+// @Success 200 {array} domain.DiscoverStatusLog "Status transitions"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/status/logs [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) FindStatusLogs(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindStatusLogs", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseRequiredIDParam(c.Query("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	logs, err := s.uc.FindStatusLogs(ctx, id)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, logs)
+}
+
+// FindHistory Get the revision history of a section item
+//
+// @Summary Get the revision history of an item
+// @Description Lists every stored revision of an item with its full snapshot and actor, newest first
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param id query int true "item id"
+// @Success 200 {array} domain.DiscoverRevision "Revisions"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid id"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/history [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) FindHistory(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindHistory", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	id, err := parseRequiredIDParam(c.Query("id"))
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	history, err := s.uc.History(ctx, id)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, history)
+}
+
+// Rollback Restore a previous revision of a section item
+//
+// @Summary Roll back an item
+// @Description Restores the content of the chosen revision, which is stored as a new revision. Only draft and
+// @Description rejected items can be rolled back
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverRollbackReq true "Rollback request"
+// @Success 200 {object} domain.DiscoverArticles "Restored item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/rollback [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Rollback(c *gin.Context) {
+	var (
+		req domain.DiscoverRollbackReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Rollback", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Rollback(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// FindTrash Get paginated list of deleted section items
+//
+// @Summary Get deleted items
+// @Description Retrieves soft-deleted items of the section, most recently deleted first, with who deleted them and when
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param page query int false "Page number" default(1)
+// @Param limit query int false "Page size" default(10)
+// @Param title query string false "Title name" default("")
+// @Success 200 {array} domain.DiscoverArticles "List of deleted items"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid pagination parameters"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/trash [get]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) FindTrash(c *gin.Context) {
+	var err error
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while FindTrash", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	page, limit, err := parsePaginationParams(c)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	if page <= 0 || limit <= 0 {
+		err = errs.ErrArgs.WrapMsg("invalid pagination number: " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req := domain.DiscoverTrashFindReq{
+		Page:  page,
+		Limit: limit,
+		Title: c.DefaultQuery("title", ""),
+	}
+
+	items, total, err := s.uc.FindTrash(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	apiresp.GinSuccess(c, gin.H{
+		"total": total,
+		"data":  items,
+	})
+}
+
+// Restore Restore a deleted section item
+//
+// @Summary Restore a deleted item
+// @Description Moves an item of the section out of the trash as a draft, after re-checking its title and position
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverRestoreReq true "Restore request"
+// @Success 200 {object} domain.DiscoverArticles "Restored item"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/restore [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Restore(c *gin.Context) {
+	var (
+		req domain.DiscoverRestoreReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Restore", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	item, err := s.uc.Restore(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, item)
+}
+
+// Reorder Set the display order of all live section items
+//
+// @Summary Reorder items
+// @Description Renumbers positions 1..n following the given IDs, which must be exactly the set of non-deleted items
+// @Tags DiscoverSections
+// @Accept json
+// @Produce json
+// @Param section path string true "Section" Enums(article, carousel, announcement, quicklink, poll, event, group)
+// @Param request body domain.DiscoverReorderReq true "Reorder request"
+// @Success 200 {array} domain.DiscoverArticles "Reordered items"
+// @Failure 400 {object} apiresp.ApiResponse "Invalid json payload bad request"
+// @Failure 500 {object} apiresp.ApiResponse "Internal server error"
+// @Router /bo/discover/{section}/reorder [post]
+// @Security ApiKeyAuth
+func (s *sectionHandler[T, P, A, E]) Reorder(c *gin.Context) {
+	var (
+		req domain.DiscoverReorderReq
+		err error
+	)
+
+	ctx, span := otel.Tracer(domain.TracerLevelHandler).
+		Start(c.Request.Context(), tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+			log.ZError(ctx, "an error occurred while Reorder", err, "itemType", s.uc.ItemType())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", mcontext.GetOpUserID(c)),
+		attribute.String("platformID", mcontext.GetOpUserPlatform(c)),
+		attribute.String("operationID", mcontext.GetOperationID(c)),
+	)
+
+	err = c.ShouldBindJSON(&req)
+	if err != nil {
+		err = errs.ErrArgs.WrapMsg("invalid json payload " + http.StatusText(http.StatusBadRequest))
+		apiresp.GinError(c, err)
+		return
+	}
+
+	req.OperatedBy, err = getOperatedByUser(c, "")
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+
+	items, err := s.uc.Reorder(ctx, &req)
+	if err != nil {
+		apiresp.GinError(c, err)
+		return
+	}
+	apiresp.GinSuccess(c, items)
+}
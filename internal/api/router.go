@@ -22,25 +22,41 @@ func SetRouter(svcName string, api *discoverapi.Api, mw *discovermw.MW) *gin.Eng
 	r.Use(mw.GinParseToken())
 	r.Use(otelgin.Middleware(svcName))
 
-	article := r.Group("/discover/article")
-	article.GET("/find", handler.FindArticles)
+	r.GET("/discover/page", handler.GetPage)
+	r.POST("/discover/events", handler.TrackEvents)
 
-	carousel := r.Group("/discover/carousel")
-	carousel.GET("/find", handler.FindCarousels)
+	category := r.Group("/discover/category")
+	category.GET("/find", handler.FindCategories)
 
 	bo := r.Group("/bo", mw.CheckAdmin)
 
-	carouselAdmin := bo.Group("/discover/carousel")
-	carouselAdmin.GET("/find", handler.FindCarousels)
-	carouselAdmin.POST("/add", handler.CreateCarousel)
-	carouselAdmin.DELETE("/del", handler.DeleteCarousel)
-	carouselAdmin.POST("/edit", handler.EditCarousel)
-
-	articleAdmin := bo.Group("/discover/article")
-	articleAdmin.GET("/find", handler.FindArticles)
-	articleAdmin.POST("/add", handler.CreateArticle)
-	articleAdmin.DELETE("/del", handler.DeleteArticle)
-	articleAdmin.POST("/edit", handler.EditArticle)
+	handler.RegisterSections(r.Group("/discover"), bo.Group("/discover"))
+
+	categoryAdmin := bo.Group("/discover/category")
+	categoryAdmin.GET("/find", handler.AdminFindCategories)
+	categoryAdmin.POST("/add", handler.CreateCategory)
+	categoryAdmin.POST("/edit", handler.EditCategory)
+	categoryAdmin.DELETE("/del", handler.DeleteCategory)
+
+	campaignAdmin := bo.Group("/discover/campaign")
+	campaignAdmin.GET("/find", handler.FindCampaigns)
+	campaignAdmin.GET("/get", handler.GetCampaign)
+	campaignAdmin.POST("/add", handler.CreateCampaign)
+	campaignAdmin.POST("/edit", handler.EditCampaign)
+	campaignAdmin.DELETE("/del", handler.DeleteCampaign)
+	campaignAdmin.POST("/pause", handler.PauseCampaign)
+	campaignAdmin.POST("/resume", handler.ResumeCampaign)
+	campaignAdmin.POST("/items/add", handler.AddCampaignItems)
+	campaignAdmin.POST("/items/remove", handler.RemoveCampaignItems)
+
+	pageAdmin := bo.Group("/discover/page")
+	pageAdmin.GET("/layout", handler.FindPageLayout)
+	pageAdmin.POST("/layout", handler.SavePageLayout)
+
+	bo.GET("/discover/stats", handler.FindItemStats)
+
+	targetingAdmin := bo.Group("/discover/targeting")
+	targetingAdmin.POST("/dry-run", handler.DryRunTargeting)
 
 	return r
 }
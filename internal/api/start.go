@@ -7,6 +7,7 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"sync"
 	"syscall"
 	"time"
 
@@ -17,6 +18,8 @@ import (
 
 	"github.com/1nterdigital/aka-im-discover/internal/api/mw"
 	"github.com/1nterdigital/aka-im-discover/internal/api/util"
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-discover/internal/job"
 	"github.com/1nterdigital/aka-im-discover/internal/repository"
 	"github.com/1nterdigital/aka-im-discover/internal/service"
 	"github.com/1nterdigital/aka-im-discover/internal/usecase"
@@ -74,10 +77,16 @@ func initDatabase(ctx context.Context, cfg *Config) (*gorm.DB, *mysqlutil.Client
 
 // initService wires up repository, usecase, and discover service
 func initService(
-	cfg *Config, conn *gorm.DB, pgDB *mysqlutil.Client, rdb redis.UniversalClient,
+	cfg *Config, conn *gorm.DB, pgDB *mysqlutil.Client, rdb redis.UniversalClient, im imapi.CallerInterface,
 ) (*discoverService, *usecase.UseCase, error) {
-	repo := repository.NewRepository(conn)
-	uc, err := usecase.New(repo)
+	repo := repository.NewRepository(conn, rdb)
+	uc, err := usecase.New(repo, im, usecase.TrackingOptions{
+		BatchSize:    cfg.ApiConfig.Tracking.BatchSize,
+		Interval:     time.Duration(cfg.ApiConfig.Tracking.FlushIntervalMs) * time.Millisecond,
+		Workers:      cfg.ApiConfig.Tracking.Workers,
+		Buffer:       cfg.ApiConfig.Tracking.Buffer,
+		LookbackDays: cfg.ApiConfig.Stats.LookbackDays,
+	})
 	if err != nil {
 		return nil, nil, err
 	}
@@ -128,11 +137,45 @@ func Start(ctx context.Context, index int, cfg *Config) (err error) {
 		return err
 	}
 
+	// External dependencies
+	im := imapi.New(cfg.Share.AkaIM.ApiURL, cfg.Share.AkaIM.Secret, cfg.Share.AkaIM.AdminUserID)
+
 	// Service + usecase
-	srv, uc, err := initService(cfg, conn, pgDB, rdb)
+	srv, uc, err := initService(cfg, conn, pgDB, rdb, im)
 	if err != nil {
 		return err
 	}
+	// Background jobs
+	trashPurge := job.NewTrashPurge(
+		cfg.ApiConfig.Trash.RetentionDays,
+		cfg.ApiConfig.Trash.PurgeIntervalMinutes,
+		map[string]job.Purger{
+			domain.ItemTypeArticle:      uc.DiscoverArticles,
+			domain.ItemTypeCarousel:     uc.DiscoverCarousels,
+			domain.ItemTypeAnnouncement: uc.DiscoverAnnouncements,
+			domain.ItemTypeQuickLink:    uc.DiscoverQuickLinks,
+			domain.ItemTypePoll:         uc.DiscoverPolls,
+			domain.ItemTypeEvent:        uc.DiscoverEvents,
+			domain.ItemTypeGroup:        uc.DiscoverFeaturedGroups,
+		},
+	)
+	go trashPurge.Run(ctx)
+
+	trendingWindows := domain.TrendingWindows(cfg.ApiConfig.Trending.WindowsHours)
+	trendingRefresh := job.NewTrendingRefresh(
+		trendingWindows,
+		cfg.ApiConfig.Trending.HalfLifeHours,
+		cfg.ApiConfig.Trending.RefreshIntervalMinutes,
+		uc.DiscoverTrending,
+	)
+	go trendingRefresh.Run(ctx)
+
+	statsRollup := job.NewStatsRollup(
+		cfg.ApiConfig.Stats.LookbackDays,
+		cfg.ApiConfig.Stats.RollupIntervalMinutes,
+		uc.DiscoverStats,
+	)
+	go statsRollup.Run(ctx)
 
 	// Discovery client
 	client, err := kdisc.NewDiscoveryRegister(&cfg.Discovery, cfg.RuntimeEnv, nil)
@@ -140,12 +183,16 @@ func Start(ctx context.Context, index int, cfg *Config) (err error) {
 		return err
 	}
 
-	// External dependencies
-	im := imapi.New(cfg.Share.AkaIM.ApiURL, cfg.Share.AkaIM.Secret, cfg.Share.AkaIM.AdminUserID)
 	base := util.Api{
 		ImUserID:            cfg.Share.AkaIM.AdminUserID,
 		ProxyHeader:         cfg.Share.ProxyHeader,
 		DiscoverAdminUserID: cfg.Share.DiscoverAdmin[0],
+		DefaultLocale:       cfg.ApiConfig.Locale.Default,
+		TrendingWindows:     trendingWindows,
+		LinkPolicy: domain.LinkPolicy{
+			Schemes: cfg.ApiConfig.Links.AllowedSchemes,
+			Hosts:   cfg.ApiConfig.Links.AllowedHosts,
+		},
 	}
 
 	// API + middleware
@@ -183,23 +230,32 @@ func Start(ctx context.Context, index int, cfg *Config) (err error) {
 	}
 
 	// Graceful shutdown
+	// The tracking events still queued are inserted once the server stopped serving the
+	// requests that could queue more.
 	timeoutShutdown := 15 * time.Second
-	return gracefulShutdown(server, timeoutShutdown, netDone, netErr)
+	return gracefulShutdown(server, timeoutShutdown, netDone, netErr, uc.DiscoverTracking.Close)
 }
 
-func shutdown(server *http.Server, timeout time.Duration) func() error {
+// shutdown stops the server, then runs drained once its requests are done or timed out.
+func shutdown(server *http.Server, timeout time.Duration, drained func()) func() error {
 	return func() error {
 		ctx, cancel := context.WithTimeout(context.Background(), timeout)
 		defer cancel()
-		if err := server.Shutdown(ctx); err != nil {
+		err := server.Shutdown(ctx)
+		drained()
+		if err != nil {
 			return errs.WrapMsg(err, "shutdown err")
 		}
 		return nil
 	}
 }
 
-func gracefulShutdown(server *http.Server, timeout time.Duration, netDone chan struct{}, netErr error) error {
-	sd := shutdown(server, timeout)
+func gracefulShutdown(
+	server *http.Server, timeout time.Duration, netDone chan struct{}, netErr error, drained func(),
+) error {
+	// Both the registry and the signal below may shut the server down.
+	drained = sync.OnceFunc(drained)
+	sd := shutdown(server, timeout, drained)
 	disetcd.RegisterShutDown(sd)
 
 	sigs := make(chan os.Signal, 1)
@@ -213,6 +269,7 @@ func gracefulShutdown(server *http.Server, timeout time.Duration, netDone chan s
 		return sd()
 	case <-netDone:
 		close(netDone)
+		drained()
 		return netErr
 	}
 }
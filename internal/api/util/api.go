@@ -1,7 +1,14 @@
 package util
 
+import (
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+)
+
 type Api struct {
 	ImUserID            string
 	ProxyHeader         string
 	DiscoverAdminUserID string
+	DefaultLocale       string
+	TrendingWindows     []int
+	LinkPolicy          domain.LinkPolicy
 }
@@ -0,0 +1,44 @@
+package domain
+
+import (
+	"time"
+)
+
+// ContentFields are the add and edit fields shared by every discover section.
+type ContentFields struct {
+	Title     string
+	ImageURL  string
+	LinkURL   string
+	Locales   Locales
+	Targeting *TargetingRules
+	Position  *int
+	PublishAt *time.Time
+	ExpireAt  *time.Time
+	// ClearPublishAt and ClearExpireAt, on edit, remove the bound instead of leaving
+	// it unchanged.
+	ClearPublishAt bool
+	ClearExpireAt  bool
+}
+
+// ContentFindReq lists the items of a section. Filters holds the section-specific
+// query params by name, for the params the section declares, IDs, when set, the only
+// items to consider and ExcludeIDs the items left out for the caller.
+type ContentFindReq struct {
+	ID         int64             `json:"id"`
+	Page       int32             `validate:"min=1"`
+	Limit      int32             `validate:"min=1,max=100"`
+	Title      string            `json:"title"`
+	SortBy     string            `json:"sortBy"`
+	Order      string            `json:"order"`
+	Schedule   string            `json:"schedule"`
+	Status     string            `json:"status"`
+	Audience   *Audience         `json:"-"`
+	Filters    map[string]string `json:"-"`
+	IDs        []int64           `json:"-"`
+	ExcludeIDs []int64           `json:"-"`
+}
+
+type ContentDeleteReq struct {
+	ID        int64  `json:"id" binding:"required"`
+	DeletedBy string `json:"deletedBy"`
+}
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"time"
+)
+
+// Editorial statuses of discover items. Only published items are visible on the public routes.
+const (
+	StatusDraft     = "draft"
+	StatusInReview  = "in_review"
+	StatusPublished = "published"
+	StatusRejected  = "rejected"
+)
+
+// Actions moving a discover item from one status to another.
+const (
+	StatusActionSubmit    = "submit"
+	StatusActionWithdraw  = "withdraw"
+	StatusActionApprove   = "approve"
+	StatusActionReject    = "reject"
+	StatusActionUnpublish = "unpublish"
+)
+
+// Item types recorded next to the per-item history rows.
+const (
+	ItemTypeArticle      = "article"
+	ItemTypeCarousel     = "carousel"
+	ItemTypeAnnouncement = "announcement"
+	ItemTypeQuickLink    = "quicklink"
+	ItemTypePoll         = "poll"
+	ItemTypeEvent        = "event"
+	ItemTypeGroup        = "group"
+)
+
+// statusTransitions maps every action to the statuses it may be applied to
+// and the status it leads to.
+var statusTransitions = map[string]map[string]string{
+	StatusActionSubmit:    {StatusDraft: StatusInReview, StatusRejected: StatusInReview},
+	StatusActionWithdraw:  {StatusInReview: StatusDraft},
+	StatusActionApprove:   {StatusInReview: StatusPublished},
+	StatusActionReject:    {StatusInReview: StatusRejected},
+	StatusActionUnpublish: {StatusPublished: StatusDraft},
+}
+
+// NextStatus returns the status reached by applying action to an item in status from.
+// ok is false when the transition is not allowed.
+func NextStatus(from, action string) (to string, ok bool) {
+	to, ok = statusTransitions[action][from]
+	return to, ok
+}
+
+// IsReviewAction reports whether action is a review decision, which has to be
+// taken by another admin than the one who submitted the item.
+func IsReviewAction(action string) bool {
+	return action == StatusActionApprove || action == StatusActionReject
+}
+
+// ValidStatus reports whether status is one of the editorial statuses.
+func ValidStatus(status string) bool {
+	switch status {
+	case StatusDraft, StatusInReview, StatusPublished, StatusRejected:
+		return true
+	default:
+		return false
+	}
+}
+
+type DiscoverStatusReq struct {
+	ID         int64  `json:"id" binding:"required"`
+	Action     string `json:"action" binding:"required,oneof=submit withdraw approve reject unpublish"`
+	Note       string `json:"note"`
+	OperatedBy string `json:"-"`
+}
+
+type DiscoverStatusLog struct {
+	ID         int64     `json:"id"`
+	ItemType   string    `json:"itemType"`
+	ItemID     int64     `json:"itemId"`
+	Action     string    `json:"action"`
+	FromStatus string    `json:"fromStatus"`
+	ToStatus   string    `json:"toStatus"`
+	Note       string    `json:"note"`
+	CreatedAt  time.Time `json:"createdAt"`
+	CreatedBy  string    `json:"createdBy"`
+}
@@ -6,47 +6,92 @@ import (
 )
 
 type DiscoverArticles struct {
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	ImageURL  string    `json:"imageUrl"`
-	LinkURL   string    `json:"linkUrl"`
-	IsActive  bool      `json:"isActive"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"createdAt"`
-	CreatedBy string    `json:"createdBy"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	UpdatedBy string    `json:"updatedBy"`
-	DeletedAt time.Time `json:"deletedAt"`
-	DeletedBy string    `json:"deletedBy"`
+	ID          int64           `json:"id"`
+	Title       string          `json:"title"`
+	ImageURL    string          `json:"imageUrl"`
+	LinkURL     string          `json:"linkUrl"`
+	Body        string          `json:"body,omitempty"`
+	BodyHTML    string          `json:"bodyHtml,omitempty"`
+	Locales     Locales         `json:"locales,omitempty"`
+	Targeting   *TargetingRules `json:"targeting,omitempty"`
+	CategoryID  *int64          `json:"categoryId"`
+	Tags        []string        `json:"tags"`
+	Status      string          `json:"status"`
+	Position    int             `json:"position"`
+	PublishAt   *time.Time      `json:"publishAt"`
+	ExpireAt    *time.Time      `json:"expireAt"`
+	CampaignID  *int64          `json:"campaignId"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	UpdatedBy   string          `json:"updatedBy"`
+	DeletedAt   time.Time       `json:"deletedAt"`
+	DeletedBy   string          `json:"deletedBy"`
+	SubmittedBy string          `json:"submittedBy"`
+	ReviewedBy  string          `json:"reviewedBy"`
+	// Related holds the suggestions of the public article detail.
+	Related []DiscoverArticles `json:"related,omitempty"`
 }
 
 type DiscoverArticlesAddReq struct {
-	Title     string `json:"title" binding:"required"`
-	ImageURL  string `json:"imageUrl" binding:"required"`
-	LinkURL   string `json:"linkUrl" binding:"required"`
-	CreatedBy string `json:"createdBy"`
-	Position  *int   `json:"position"`
+	Title      string          `json:"title" binding:"required"`
+	ImageURL   string          `json:"imageUrl" binding:"required"`
+	LinkURL    string          `json:"linkUrl" binding:"required_without=Body"`
+	Body       string          `json:"body"`
+	Locales    Locales         `json:"locales"`
+	Targeting  *TargetingRules `json:"targeting"`
+	CategoryID *int64          `json:"categoryId"`
+	Tags       []string        `json:"tags"`
+	CreatedBy  string          `json:"createdBy"`
+	Position   *int            `json:"position"`
+	PublishAt  *time.Time      `json:"publishAt"`
+	ExpireAt   *time.Time      `json:"expireAt"`
 }
 
-type DiscoverArticlesDeleteReq struct {
-	ID        int64  `json:"id" binding:"required"`
-	DeletedBy string `json:"deletedBy"`
+type DiscoverArticlesEditReq struct {
+	ID             int64           `json:"id" binding:"required"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	Body           *string         `json:"body"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	CategoryID     *int64          `json:"categoryId"`
+	Tags           []string        `json:"tags"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
 }
 
-type DiscoverArticlesEditReq struct {
-	ID        int64  `json:"id" binding:"required"`
-	Title     string `json:"title"`
-	ImageURL  string `json:"imageUrl"`
-	LinkURL   string `json:"linkUrl"`
-	UpdatedBy string `json:"updatedBy"`
-	Position  *int   `json:"position"`
+// Content returns the fields shared with the other sections.
+func (r *DiscoverArticlesAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
 }
 
-type DiscoverArticlesFindReq struct {
-	ID     int64  `json:"id"`
-	Page   int32  `validate:"min=1"`
-	Limit  int32  `validate:"min=1,max=100"`
-	Title  string `json:"title"`
-	SortBy string `json:"sortBy"`
-	Order  string `json:"order"`
+// Content returns the fields shared with the other sections.
+func (r *DiscoverArticlesEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
 }
@@ -0,0 +1,122 @@
+package domain
+
+import (
+	"time"
+)
+
+// Combined statuses of a campaign, derived from its pause flag, its window and the
+// editorial statuses of its items.
+const (
+	CampaignStatusEmpty     = "empty"
+	CampaignStatusPaused    = "paused"
+	CampaignStatusScheduled = "scheduled"
+	CampaignStatusEnded     = "ended"
+	CampaignStatusPending   = "pending"
+	CampaignStatusPartial   = "partial"
+	CampaignStatusLive      = "live"
+)
+
+// CampaignItemTypes lists the sections whose items can belong to a campaign.
+var CampaignItemTypes = []string{ItemTypeCarousel, ItemTypeArticle}
+
+type DiscoverCampaigns struct {
+	ID        int64                  `json:"id"`
+	Name      string                 `json:"name"`
+	Paused    bool                   `json:"paused"`
+	PublishAt *time.Time             `json:"publishAt"`
+	ExpireAt  *time.Time             `json:"expireAt"`
+	Status    string                 `json:"status"`
+	Items     []DiscoverCampaignItem `json:"items"`
+	CreatedAt time.Time              `json:"createdAt"`
+	CreatedBy string                 `json:"createdBy"`
+	UpdatedAt time.Time              `json:"updatedAt"`
+	UpdatedBy string                 `json:"updatedBy"`
+}
+
+type DiscoverCampaignItem struct {
+	ItemType string `json:"itemType"`
+	ID       int64  `json:"id"`
+	Title    string `json:"title"`
+	Status   string `json:"status"`
+}
+
+type DiscoverCampaignsAddReq struct {
+	Name      string     `json:"name" binding:"required"`
+	PublishAt *time.Time `json:"publishAt"`
+	ExpireAt  *time.Time `json:"expireAt"`
+	CreatedBy string     `json:"createdBy"`
+}
+
+// DiscoverCampaignsEditReq changes the given fields of a campaign; a new window
+// applies to all of its items at once. ClearPublishAt and ClearExpireAt remove a
+// bound, which a nil PublishAt or ExpireAt leaves unchanged.
+type DiscoverCampaignsEditReq struct {
+	ID             int64      `json:"id" binding:"required"`
+	Name           string     `json:"name"`
+	PublishAt      *time.Time `json:"publishAt"`
+	ExpireAt       *time.Time `json:"expireAt"`
+	ClearPublishAt bool       `json:"clearPublishAt"`
+	ClearExpireAt  bool       `json:"clearExpireAt"`
+	UpdatedBy      string     `json:"updatedBy"`
+}
+
+type DiscoverCampaignsDeleteReq struct {
+	ID        int64  `json:"id" binding:"required"`
+	DeletedBy string `json:"deletedBy"`
+}
+
+type DiscoverCampaignsFindReq struct {
+	Page  int32  `validate:"min=1"`
+	Limit int32  `validate:"min=1,max=100"`
+	Name  string `json:"name"`
+}
+
+// DiscoverCampaignPauseReq pauses or resumes a campaign.
+type DiscoverCampaignPauseReq struct {
+	ID         int64  `json:"id" binding:"required"`
+	OperatedBy string `json:"-"`
+}
+
+type DiscoverCampaignItemRef struct {
+	ItemType string `json:"itemType" binding:"required,oneof=carousel article"`
+	ItemID   int64  `json:"itemId" binding:"required"`
+}
+
+// DiscoverCampaignItemsReq adds items to or removes them from a campaign.
+type DiscoverCampaignItemsReq struct {
+	ID         int64                     `json:"id" binding:"required"`
+	Items      []DiscoverCampaignItemRef `json:"items" binding:"required,min=1,dive"`
+	OperatedBy string                    `json:"-"`
+}
+
+// CampaignStatus combines the state of a campaign with the statuses of its items.
+// A campaign in its window is live once all of its items are published, partial while
+// only some are and pending while none is.
+func CampaignStatus(paused bool, publishAt, expireAt *time.Time, itemStatuses []string, now time.Time) string {
+	switch {
+	case len(itemStatuses) == 0:
+		return CampaignStatusEmpty
+	case paused:
+		return CampaignStatusPaused
+	case expireAt != nil && !expireAt.After(now):
+		return CampaignStatusEnded
+	case publishAt != nil && publishAt.After(now):
+		return CampaignStatusScheduled
+	}
+
+	published := 0
+	for _, status := range itemStatuses {
+		if status == StatusPublished {
+			published++
+		}
+	}
+
+	switch published {
+	case len(itemStatuses):
+		return CampaignStatusLive
+	case 0:
+		return CampaignStatusPending
+	default:
+		return CampaignStatusPartial
+	}
+}
@@ -6,47 +6,123 @@ import (
 )
 
 type DiscoverCarousels struct {
-	ID        int64     `json:"id"`
-	Title     string    `json:"title"`
-	ImageURL  string    `json:"imageUrl"`
-	LinkURL   string    `json:"linkUrl"`
-	IsActive  bool      `json:"isActive"`
-	Position  int       `json:"position"`
-	CreatedAt time.Time `json:"createdAt"`
-	CreatedBy string    `json:"createdBy"`
-	UpdatedAt time.Time `json:"updatedAt"`
-	UpdatedBy string    `json:"updatedBy"`
-	DeletedAt time.Time `json:"deletedAt"`
-	DeletedBy string    `json:"deletedBy"`
+	ID         int64           `json:"id"`
+	Title      string          `json:"title"`
+	ImageURL   string          `json:"imageUrl"`
+	LinkURL    string          `json:"linkUrl"`
+	Locales    Locales         `json:"locales,omitempty"`
+	Targeting  *TargetingRules `json:"targeting,omitempty"`
+	Status     string          `json:"status"`
+	Position   int             `json:"position"`
+	PublishAt  *time.Time      `json:"publishAt"`
+	ExpireAt   *time.Time      `json:"expireAt"`
+	CampaignID *int64          `json:"campaignId"`
+	// Experiment is only shown in the back office; the public routes serve the
+	// assigned variant, named by Variant, in place of the slide.
+	Experiment  *DiscoverCarouselExperiment `json:"experiment,omitempty"`
+	Variant     string                      `json:"variant,omitempty"`
+	CreatedAt   time.Time                   `json:"createdAt"`
+	CreatedBy   string                      `json:"createdBy"`
+	UpdatedAt   time.Time                   `json:"updatedAt"`
+	UpdatedBy   string                      `json:"updatedBy"`
+	DeletedAt   time.Time                   `json:"deletedAt"`
+	DeletedBy   string                      `json:"deletedBy"`
+	SubmittedBy string                      `json:"submittedBy"`
+	ReviewedBy  string                      `json:"reviewedBy"`
 }
 
-type DiscoverCarouselsAddReq struct {
-	Title     string `json:"title" binding:"required"`
-	ImageURL  string `json:"imageUrl" binding:"required"`
-	LinkURL   string `json:"linkUrl" binding:"required"`
-	CreatedBy string `json:"createdBy"`
-	Position  *int   `json:"position"`
+// DiscoverCarouselVariant is one alternative of a slide in an experiment; its empty
+// fields keep the slide's. Users are split between variants in proportion to Weight.
+type DiscoverCarouselVariant struct {
+	Key      string `json:"key" binding:"required,max=32"`
+	Title    string `json:"title"`
+	ImageURL string `json:"imageUrl"`
+	LinkURL  string `json:"linkUrl"`
+	Weight   int    `json:"weight" binding:"required,min=1,max=1000"`
+}
+
+type DiscoverCarouselExperiment struct {
+	ID        string                    `json:"id"`
+	StartedAt time.Time                 `json:"startedAt"`
+	Variants  []DiscoverCarouselVariant `json:"variants"`
+}
+
+// DiscoverCarouselVariantStats is the engagement of one variant since its experiment started.
+type DiscoverCarouselVariantStats struct {
+	Key         string  `json:"key"`
+	Weight      int     `json:"weight"`
+	Impressions int64   `json:"impressions"`
+	Clicks      int64   `json:"clicks"`
+	CTR         float64 `json:"ctr"`
+}
+
+// DiscoverCarouselPromoteReq makes a variant the slide itself and ends the experiment.
+// ExperimentID is the experiment the variant was picked from.
+type DiscoverCarouselPromoteReq struct {
+	ID           int64  `json:"id" binding:"required"`
+	ExperimentID string `json:"experimentId" binding:"required"`
+	Key          string `json:"key" binding:"required"`
+	OperatedBy   string `json:"-"`
 }
 
-type DiscoverCarouselsDeleteReq struct {
-	ID        int64  `json:"id" binding:"required"`
-	DeletedBy string `json:"deletedBy"`
+type DiscoverCarouselsAddReq struct {
+	Title     string          `json:"title" binding:"required"`
+	ImageURL  string          `json:"imageUrl" binding:"required"`
+	LinkURL   string          `json:"linkUrl" binding:"required"`
+	Locales   Locales         `json:"locales"`
+	Targeting *TargetingRules `json:"targeting"`
+	CreatedBy string          `json:"createdBy"`
+	Position  *int            `json:"position"`
+	PublishAt *time.Time      `json:"publishAt"`
+	ExpireAt  *time.Time      `json:"expireAt"`
+	// Variants, when set, starts an experiment between at least two variants.
+	Variants []DiscoverCarouselVariant `json:"variants" binding:"omitempty,max=10,dive"`
 }
 
 type DiscoverCarouselsEditReq struct {
-	ID        int64  `json:"id" binding:"required"`
-	Title     string `json:"title"`
-	ImageURL  string `json:"imageUrl"`
-	LinkURL   string `json:"linkUrl"`
-	UpdatedBy string `json:"updatedBy"`
-	Position  *int   `json:"position"`
-}
-
-type DiscoverCarouselsFindReq struct {
-	ID     int64  `json:"id"`
-	Page   int32  `validate:"min=1"`
-	Limit  int32  `validate:"min=1,max=100"`
-	Title  string `json:"title"`
-	SortBy string `json:"sortBy"`
-	Order  string `json:"order"`
+	ID             int64           `json:"id" binding:"required"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
+	// Variants, when present, replaces the experiment with a new one, reassigning the
+	// users and restarting its statistics; an empty list ends it.
+	Variants []DiscoverCarouselVariant `json:"variants" binding:"omitempty,max=10,dive"`
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverCarouselsAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverCarouselsEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
 }
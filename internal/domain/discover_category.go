@@ -0,0 +1,93 @@
+package domain
+
+import (
+	"strings"
+	"time"
+)
+
+const (
+	maxTags      = 10
+	maxTagLength = 32
+)
+
+type DiscoverCategories struct {
+	ID           int64      `json:"id"`
+	Slug         string     `json:"slug"`
+	Name         string     `json:"name"`
+	IconURL      string     `json:"iconUrl"`
+	Locales      Locales    `json:"locales,omitempty"`
+	Position     int        `json:"position"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	CreatedBy    string     `json:"createdBy"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	UpdatedBy    string     `json:"updatedBy"`
+	DeletedAt    *time.Time `json:"deletedAt"`
+	DeletedBy    string     `json:"deletedBy"`
+	ArticleCount int64      `json:"articleCount"`
+}
+
+type DiscoverCategoriesAddReq struct {
+	Slug      string  `json:"slug" binding:"required"`
+	Name      string  `json:"name" binding:"required"`
+	IconURL   string  `json:"iconUrl"`
+	Locales   Locales `json:"locales"`
+	Position  *int    `json:"position"`
+	CreatedBy string  `json:"createdBy"`
+}
+
+type DiscoverCategoriesEditReq struct {
+	ID        int64   `json:"id" binding:"required"`
+	Slug      string  `json:"slug"`
+	Name      string  `json:"name"`
+	IconURL   string  `json:"iconUrl"`
+	Locales   Locales `json:"locales"`
+	Position  *int    `json:"position"`
+	UpdatedBy string  `json:"updatedBy"`
+}
+
+type DiscoverCategoriesDeleteReq struct {
+	ID        int64  `json:"id" binding:"required"`
+	DeletedBy string `json:"deletedBy"`
+}
+
+// NormalizeSlug lowercases a category slug; it returns false when the slug has
+// characters other than letters, digits, "-" and "_".
+func NormalizeSlug(slug string) (string, bool) {
+	slug = strings.ToLower(strings.TrimSpace(slug))
+	if slug == "" || len(slug) > 64 {
+		return "", false
+	}
+	for _, r := range slug {
+		if (r < 'a' || r > 'z') && (r < '0' || r > '9') && r != '-' && r != '_' {
+			return "", false
+		}
+	}
+	return slug, true
+}
+
+// NormalizeTags trims, lowercases and de-duplicates article tags, keeping their order.
+// A nil slice stays nil so that edits can leave tags unchanged.
+func NormalizeTags(tags []string) ([]string, bool) {
+	if tags == nil {
+		return nil, true
+	}
+
+	seen := make(map[string]struct{}, len(tags))
+	resp := make([]string, 0, len(tags))
+	for _, tag := range tags {
+		tag = strings.ToLower(strings.TrimSpace(tag))
+		if tag == "" || len(tag) > maxTagLength {
+			return nil, false
+		}
+		if _, ok := seen[tag]; ok {
+			continue
+		}
+		seen[tag] = struct{}{}
+		resp = append(resp, tag)
+	}
+
+	if len(resp) > maxTags {
+		return nil, false
+	}
+	return resp, true
+}
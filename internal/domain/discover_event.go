@@ -0,0 +1,113 @@
+//nolint:dupl // similar to other entity
+package domain
+
+import (
+	"time"
+)
+
+// Timeframe filters of the event finds, relative to the time of the request.
+const (
+	TimeframeAll      = "all"
+	TimeframeCurrent  = "current"
+	TimeframeUpcoming = "upcoming"
+	TimeframeOngoing  = "ongoing"
+	TimeframePast     = "past"
+)
+
+type DiscoverEvents struct {
+	ID          int64           `json:"id"`
+	Title       string          `json:"title"`
+	ImageURL    string          `json:"imageUrl"`
+	LinkURL     string          `json:"linkUrl"`
+	Location    string          `json:"location"`
+	StartsAt    time.Time       `json:"startsAt"`
+	EndsAt      time.Time       `json:"endsAt"`
+	Capacity    *int            `json:"capacity"`
+	RSVPCount   int             `json:"rsvpCount"`
+	Locales     Locales         `json:"locales,omitempty"`
+	Targeting   *TargetingRules `json:"targeting,omitempty"`
+	Status      string          `json:"status"`
+	Position    int             `json:"position"`
+	PublishAt   *time.Time      `json:"publishAt"`
+	ExpireAt    *time.Time      `json:"expireAt"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	UpdatedBy   string          `json:"updatedBy"`
+	DeletedAt   time.Time       `json:"deletedAt"`
+	DeletedBy   string          `json:"deletedBy"`
+	SubmittedBy string          `json:"submittedBy"`
+	ReviewedBy  string          `json:"reviewedBy"`
+}
+
+// DiscoverEventsAddReq creates an event; the link is its join link for online events.
+// Without a capacity the event takes any number of RSVPs.
+type DiscoverEventsAddReq struct {
+	Title     string          `json:"title" binding:"required"`
+	ImageURL  string          `json:"imageUrl"`
+	LinkURL   string          `json:"linkUrl" binding:"required_without=Location"`
+	Location  string          `json:"location" binding:"max=255"`
+	StartsAt  *time.Time      `json:"startsAt" binding:"required"`
+	EndsAt    *time.Time      `json:"endsAt" binding:"required"`
+	Capacity  *int            `json:"capacity" binding:"omitempty,min=1"`
+	Locales   Locales         `json:"locales"`
+	Targeting *TargetingRules `json:"targeting"`
+	CreatedBy string          `json:"createdBy"`
+	Position  *int            `json:"position"`
+	PublishAt *time.Time      `json:"publishAt"`
+	ExpireAt  *time.Time      `json:"expireAt"`
+}
+
+// DiscoverEventsEditReq edits an event. A zero capacity removes the limit.
+type DiscoverEventsEditReq struct {
+	ID             int64           `json:"id" binding:"required"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	Location       *string         `json:"location" binding:"omitempty,max=255"`
+	StartsAt       *time.Time      `json:"startsAt"`
+	EndsAt         *time.Time      `json:"endsAt"`
+	Capacity       *int            `json:"capacity" binding:"omitempty,min=0"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
+}
+
+type DiscoverRSVPReq struct {
+	ID int64 `json:"id" binding:"required"`
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverEventsAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverEventsEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
+}
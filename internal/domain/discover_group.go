@@ -0,0 +1,97 @@
+//nolint:dupl // similar to other entity
+package domain
+
+import (
+	"time"
+)
+
+type DiscoverFeaturedGroups struct {
+	ID          int64           `json:"id"`
+	Title       string          `json:"title"`
+	ImageURL    string          `json:"imageUrl"`
+	LinkURL     string          `json:"linkUrl"`
+	GroupID     string          `json:"groupId"`
+	Group       *IMGroup        `json:"group,omitempty"`
+	Locales     Locales         `json:"locales,omitempty"`
+	Targeting   *TargetingRules `json:"targeting,omitempty"`
+	Status      string          `json:"status"`
+	Position    int             `json:"position"`
+	PublishAt   *time.Time      `json:"publishAt"`
+	ExpireAt    *time.Time      `json:"expireAt"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	UpdatedBy   string          `json:"updatedBy"`
+	DeletedAt   time.Time       `json:"deletedAt"`
+	DeletedBy   string          `json:"deletedBy"`
+	SubmittedBy string          `json:"submittedBy"`
+	ReviewedBy  string          `json:"reviewedBy"`
+}
+
+type IMGroup struct {
+	GroupID     string `json:"groupId"`
+	Name        string `json:"name"`
+	FaceURL     string `json:"faceUrl"`
+	MemberCount int64  `json:"memberCount"`
+}
+
+// DiscoverFeaturedGroupsAddReq pins a group; title and image, when set, override the
+// name and avatar from the IM.
+type DiscoverFeaturedGroupsAddReq struct {
+	GroupID   string          `json:"groupId" binding:"required,max=64"`
+	Title     string          `json:"title"`
+	ImageURL  string          `json:"imageUrl"`
+	LinkURL   string          `json:"linkUrl"`
+	Locales   Locales         `json:"locales"`
+	Targeting *TargetingRules `json:"targeting"`
+	CreatedBy string          `json:"createdBy"`
+	Position  *int            `json:"position"`
+	PublishAt *time.Time      `json:"publishAt"`
+	ExpireAt  *time.Time      `json:"expireAt"`
+}
+
+type DiscoverFeaturedGroupsEditReq struct {
+	ID             int64           `json:"id" binding:"required"`
+	GroupID        string          `json:"groupId" binding:"max=64"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverFeaturedGroupsAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverFeaturedGroupsEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
+}
@@ -0,0 +1,30 @@
+package domain
+
+import (
+	"time"
+)
+
+// DefaultPageSectionLimit is the number of items of a section on the discover page
+// while no layout is saved.
+const DefaultPageSectionLimit = 10
+
+type DiscoverPageSections struct {
+	ID        int64     `json:"id"`
+	Section   string    `json:"section"`
+	Position  int       `json:"position"`
+	Limit     int32     `json:"limit"`
+	CreatedAt time.Time `json:"createdAt"`
+	CreatedBy string    `json:"createdBy"`
+}
+
+type DiscoverPageSectionReq struct {
+	Section string `json:"section" binding:"required"`
+	Limit   int32  `json:"limit" binding:"required,min=1,max=50"`
+}
+
+// DiscoverPageLayoutReq replaces the discover page layout; sections are shown in the
+// order of the list.
+type DiscoverPageLayoutReq struct {
+	Sections  []DiscoverPageSectionReq `json:"sections" binding:"required,dive"`
+	UpdatedBy string                   `json:"updatedBy"`
+}
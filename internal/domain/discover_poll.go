@@ -0,0 +1,122 @@
+//nolint:dupl // similar to other entity
+package domain
+
+import (
+	"time"
+)
+
+type DiscoverPollOption struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+type DiscoverPolls struct {
+	ID          int64                `json:"id"`
+	Title       string               `json:"title"`
+	ImageURL    string               `json:"imageUrl"`
+	LinkURL     string               `json:"linkUrl"`
+	Options     []DiscoverPollOption `json:"options"`
+	MultiChoice bool                 `json:"multiChoice"`
+	OpensAt     *time.Time           `json:"opensAt"`
+	ClosesAt    *time.Time           `json:"closesAt"`
+	Locales     Locales              `json:"locales,omitempty"`
+	Targeting   *TargetingRules      `json:"targeting,omitempty"`
+	Status      string               `json:"status"`
+	Position    int                  `json:"position"`
+	PublishAt   *time.Time           `json:"publishAt"`
+	ExpireAt    *time.Time           `json:"expireAt"`
+	CreatedAt   time.Time            `json:"createdAt"`
+	CreatedBy   string               `json:"createdBy"`
+	UpdatedAt   time.Time            `json:"updatedAt"`
+	UpdatedBy   string               `json:"updatedBy"`
+	DeletedAt   time.Time            `json:"deletedAt"`
+	DeletedBy   string               `json:"deletedBy"`
+	SubmittedBy string               `json:"submittedBy"`
+	ReviewedBy  string               `json:"reviewedBy"`
+}
+
+// DiscoverPollsAddReq creates a poll. The options and the choice mode are fixed once
+// the poll exists, since votes refer to them.
+type DiscoverPollsAddReq struct {
+	Title       string          `json:"title" binding:"required"`
+	ImageURL    string          `json:"imageUrl"`
+	LinkURL     string          `json:"linkUrl"`
+	Options     []string        `json:"options" binding:"required,min=2,max=10,dive,required,max=100"`
+	MultiChoice bool            `json:"multiChoice"`
+	OpensAt     *time.Time      `json:"opensAt"`
+	ClosesAt    *time.Time      `json:"closesAt"`
+	Locales     Locales         `json:"locales"`
+	Targeting   *TargetingRules `json:"targeting"`
+	CreatedBy   string          `json:"createdBy"`
+	Position    *int            `json:"position"`
+	PublishAt   *time.Time      `json:"publishAt"`
+	ExpireAt    *time.Time      `json:"expireAt"`
+}
+
+type DiscoverPollsEditReq struct {
+	ID             int64           `json:"id" binding:"required"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	OpensAt        *time.Time      `json:"opensAt"`
+	ClosesAt       *time.Time      `json:"closesAt"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
+}
+
+type DiscoverPollVoteReq struct {
+	ID        int64   `json:"id" binding:"required"`
+	OptionIDs []int64 `json:"optionIds" binding:"required,min=1"`
+}
+
+// DiscoverPollResults is the tally of a poll as seen by one user. The votes stay
+// hidden until the user has voted.
+type DiscoverPollResults struct {
+	PollID     int64                      `json:"pollId"`
+	Voted      bool                       `json:"voted"`
+	Selected   []int64                    `json:"selected,omitempty"`
+	TotalVotes *int64                     `json:"totalVotes,omitempty"`
+	Options    []DiscoverPollOptionResult `json:"options"`
+}
+
+type DiscoverPollOptionResult struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+	Votes *int64 `json:"votes,omitempty"`
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverPollsAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverPollsEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
+}
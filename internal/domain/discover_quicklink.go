@@ -0,0 +1,87 @@
+//nolint:dupl // similar to other entity
+package domain
+
+import (
+	"time"
+)
+
+type DiscoverQuickLinks struct {
+	ID          int64           `json:"id"`
+	Title       string          `json:"title"`
+	ImageURL    string          `json:"imageUrl"`
+	LinkURL     string          `json:"linkUrl"`
+	Group       string          `json:"group"`
+	Locales     Locales         `json:"locales,omitempty"`
+	Targeting   *TargetingRules `json:"targeting,omitempty"`
+	Status      string          `json:"status"`
+	Position    int             `json:"position"`
+	PublishAt   *time.Time      `json:"publishAt"`
+	ExpireAt    *time.Time      `json:"expireAt"`
+	CreatedAt   time.Time       `json:"createdAt"`
+	CreatedBy   string          `json:"createdBy"`
+	UpdatedAt   time.Time       `json:"updatedAt"`
+	UpdatedBy   string          `json:"updatedBy"`
+	DeletedAt   time.Time       `json:"deletedAt"`
+	DeletedBy   string          `json:"deletedBy"`
+	SubmittedBy string          `json:"submittedBy"`
+	ReviewedBy  string          `json:"reviewedBy"`
+}
+
+type DiscoverQuickLinksAddReq struct {
+	Title     string          `json:"title" binding:"required"`
+	ImageURL  string          `json:"imageUrl" binding:"required"`
+	LinkURL   string          `json:"linkUrl" binding:"required"`
+	Group     string          `json:"group" binding:"max=64"`
+	Locales   Locales         `json:"locales"`
+	Targeting *TargetingRules `json:"targeting"`
+	CreatedBy string          `json:"createdBy"`
+	Position  *int            `json:"position"`
+	PublishAt *time.Time      `json:"publishAt"`
+	ExpireAt  *time.Time      `json:"expireAt"`
+}
+
+type DiscoverQuickLinksEditReq struct {
+	ID             int64           `json:"id" binding:"required"`
+	Title          string          `json:"title"`
+	ImageURL       string          `json:"imageUrl"`
+	LinkURL        string          `json:"linkUrl"`
+	Group          *string         `json:"group" binding:"omitempty,max=64"`
+	Locales        Locales         `json:"locales"`
+	Targeting      *TargetingRules `json:"targeting"`
+	UpdatedBy      string          `json:"updatedBy"`
+	Position       *int            `json:"position"`
+	PublishAt      *time.Time      `json:"publishAt"`
+	ExpireAt       *time.Time      `json:"expireAt"`
+	ClearPublishAt bool            `json:"clearPublishAt"`
+	ClearExpireAt  bool            `json:"clearExpireAt"`
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverQuickLinksAddReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:     r.Title,
+		ImageURL:  r.ImageURL,
+		LinkURL:   r.LinkURL,
+		Locales:   r.Locales,
+		Targeting: r.Targeting,
+		Position:  r.Position,
+		PublishAt: r.PublishAt,
+		ExpireAt:  r.ExpireAt,
+	}
+}
+
+// Content returns the fields shared with the other sections.
+func (r *DiscoverQuickLinksEditReq) Content() *ContentFields {
+	return &ContentFields{
+		Title:          r.Title,
+		ImageURL:       r.ImageURL,
+		LinkURL:        r.LinkURL,
+		Locales:        r.Locales,
+		Targeting:      r.Targeting,
+		Position:       r.Position,
+		PublishAt:      r.PublishAt,
+		ExpireAt:       r.ExpireAt,
+		ClearPublishAt: r.ClearPublishAt,
+		ClearExpireAt:  r.ClearExpireAt,
+	}
+}
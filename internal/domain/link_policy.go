@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// DefaultLinkSchemes are allowed when the link policy configures no scheme.
+var DefaultLinkSchemes = []string{"https"}
+
+// LinkPolicy restricts the links the click-through redirect sends clients to.
+type LinkPolicy struct {
+	// Schemes lists the allowed URL schemes.
+	Schemes []string
+	// Hosts lists the allowed hosts, each with its subdomains; empty allows any host.
+	Hosts []string
+}
+
+// Allows reports whether link is an absolute URL the policy accepts. Links carrying
+// credentials are refused, they are a common way to disguise the real host.
+func (p *LinkPolicy) Allows(link string) bool {
+	u, err := url.Parse(link)
+	if err != nil || u.Host == "" || u.User != nil {
+		return false
+	}
+
+	schemes := p.Schemes
+	if len(schemes) == 0 {
+		schemes = DefaultLinkSchemes
+	}
+	if !slices.ContainsFunc(schemes, func(scheme string) bool {
+		return strings.EqualFold(scheme, u.Scheme)
+	}) {
+		return false
+	}
+
+	if len(p.Hosts) == 0 {
+		return true
+	}
+	host := strings.ToLower(u.Hostname())
+	return slices.ContainsFunc(p.Hosts, func(allowed string) bool {
+		allowed = strings.ToLower(allowed)
+		return host == allowed || strings.HasSuffix(host, "."+allowed)
+	})
+}
@@ -0,0 +1,71 @@
+package domain
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var localePattern = regexp.MustCompile(`^[a-z]{2,3}(-[a-z0-9]{2,8})*$`)
+
+// LocalizedContent overrides an item's display fields for one locale.
+type LocalizedContent struct {
+	Title    string `json:"title"`
+	ImageURL string `json:"imageUrl"`
+	LinkURL  string `json:"linkUrl"`
+}
+
+// Locales maps a language tag such as "en" or "zh-CN" to its overrides.
+type Locales map[string]LocalizedContent
+
+// NormalizeLocale lowercases a language tag and uses "-" as separator, so "zh_CN" becomes "zh-cn".
+func NormalizeLocale(tag string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(tag), "_", "-"))
+}
+
+func ValidLocale(tag string) bool {
+	return localePattern.MatchString(tag)
+}
+
+// PreferredLocales lists the locales to try, best first: an explicit lang, then
+// the Accept-Language entries by quality, then the default locale.
+func PreferredLocales(lang, acceptLanguage, defaultLocale string) []string {
+	var prefs []string
+	if lang = NormalizeLocale(lang); ValidLocale(lang) {
+		prefs = append(prefs, lang)
+	}
+
+	type weighted struct {
+		tag string
+		q   float64
+	}
+	var accepted []weighted
+	for _, part := range strings.Split(acceptLanguage, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		tag = NormalizeLocale(tag)
+		if !ValidLocale(tag) {
+			continue
+		}
+
+		q := 1.0
+		if value, ok := strings.CutPrefix(strings.TrimSpace(params), "q="); ok {
+			parsed, err := strconv.ParseFloat(value, 64)
+			if err != nil || parsed <= 0 {
+				continue
+			}
+			q = parsed
+		}
+		accepted = append(accepted, weighted{tag: tag, q: q})
+	}
+	sort.SliceStable(accepted, func(i, j int) bool { return accepted[i].q > accepted[j].q })
+	for _, a := range accepted {
+		prefs = append(prefs, a.tag)
+	}
+
+	if defaultLocale = NormalizeLocale(defaultLocale); ValidLocale(defaultLocale) {
+		prefs = append(prefs, defaultLocale)
+	}
+
+	return prefs
+}
@@ -0,0 +1,7 @@
+package domain
+
+// DiscoverReorderReq lists every live item ID in the order it should be shown.
+type DiscoverReorderReq struct {
+	IDs        []int64 `json:"ids" binding:"required,min=1"`
+	OperatedBy string  `json:"-"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// Actions recorded on revision rows.
+const (
+	RevisionActionCreate   = "create"
+	RevisionActionEdit     = "edit"
+	RevisionActionDelete   = "delete"
+	RevisionActionRollback = "rollback"
+	RevisionActionRestore  = "restore"
+)
+
+type DiscoverRevision struct {
+	ID        int64           `json:"id"`
+	ItemType  string          `json:"itemType"`
+	ItemID    int64           `json:"itemId"`
+	Revision  int             `json:"revision"`
+	Action    string          `json:"action"`
+	Snapshot  json.RawMessage `json:"snapshot" swaggertype:"object"`
+	Note      string          `json:"note"`
+	CreatedAt time.Time       `json:"createdAt"`
+	CreatedBy string          `json:"createdBy"`
+}
+
+type DiscoverRollbackReq struct {
+	ID         int64  `json:"id" binding:"required"`
+	Revision   int    `json:"revision" binding:"required,min=1"`
+	OperatedBy string `json:"-"`
+}
@@ -0,0 +1,23 @@
+package domain
+
+import (
+	"time"
+)
+
+// Schedule filters used by the find endpoints. The public routes always use
+// ScheduleLive, while the /bo routes may ask for any of them.
+const (
+	ScheduleAll      = "all"
+	ScheduleLive     = "live"
+	ScheduleUpcoming = "upcoming"
+	ScheduleExpired  = "expired"
+)
+
+// ValidSchedule reports whether expireAt, when both bounds are set, comes after publishAt.
+func ValidSchedule(publishAt, expireAt *time.Time) bool {
+	if publishAt == nil || expireAt == nil {
+		return true
+	}
+
+	return expireAt.After(*publishAt)
+}
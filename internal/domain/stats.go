@@ -0,0 +1,64 @@
+package domain
+
+import (
+	"time"
+)
+
+// Metrics the top-N item statistics can be ranked by.
+const (
+	StatsByImpressions = "impressions"
+	StatsByClicks      = "clicks"
+	StatsByUniqueUsers = "uniqueUsers"
+	StatsByCTR         = "ctr"
+	StatsByViewers     = "viewers"
+)
+
+// Bounds of the item statistics queries.
+const (
+	// StatsDayLayout formats the UTC days the statistics are rolled up by.
+	StatsDayLayout        = "2006-01-02"
+	DefaultStatsRangeDays = 7
+	MaxStatsRangeDays     = 366
+	DefaultStatsTopLimit  = 10
+	MaxStatsTopLimit      = 100
+	// ViewersRetentionDays is how long the approximate viewer counters of a day stay in
+	// Redis; the stats rollup persists them before they expire.
+	ViewersRetentionDays = 8
+)
+
+// DiscoverItemStats holds the engagement of an item over a day, or over the whole
+// requested range in the top-N ranking, where unique users and viewers count a user once
+// however many days they came back. Viewers are the distinct users approximated with a
+// HyperLogLog, which stays cheap however many users an item reaches; over a range they
+// are only known while its days are within ViewersRetentionDays, and are 0 otherwise.
+type DiscoverItemStats struct {
+	Day         string  `json:"day,omitempty"`
+	ItemType    string  `json:"itemType"`
+	ItemID      int64   `json:"itemId"`
+	Impressions int64   `json:"impressions"`
+	Clicks      int64   `json:"clicks"`
+	UniqueUsers int64   `json:"uniqueUsers"`
+	CTR         float64 `json:"ctr"`
+	Viewers     int64   `json:"viewers"`
+}
+
+// DiscoverItemStatsFindReq filters the item statistics on an inclusive range of UTC days.
+type DiscoverItemStatsFindReq struct {
+	From     time.Time
+	To       time.Time
+	ItemType string
+	ItemID   int64
+	Page     int32
+	Limit    int32
+	// Top, when set, ranks the items over the range by By instead of listing their days.
+	Top int
+	By  string
+}
+
+// ClickThroughRate is the share of impressions that led to a click.
+func ClickThroughRate(impressions, clicks int64) float64 {
+	if impressions <= 0 {
+		return 0
+	}
+	return float64(clicks) / float64(impressions)
+}
@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"strconv"
+	"strings"
+)
+
+// Audience describes who is asking for discover content. Public requests build it
+// from the token and the client headers; the dry-run endpoint takes it as input.
+type Audience struct {
+	Platform   string `json:"platform"`
+	AppVersion string `json:"appVersion"`
+	Region     string `json:"region"`
+	UserType   int32  `json:"userType"`
+}
+
+// TargetingRules limits an item to an audience. Every non-empty field must match;
+// an empty object removes the targeting.
+type TargetingRules struct {
+	Platforms     []string `json:"platforms"`
+	MinAppVersion string   `json:"minAppVersion"`
+	MaxAppVersion string   `json:"maxAppVersion"`
+	Regions       []string `json:"regions"`
+	UserTypes     []int32  `json:"userTypes"`
+}
+
+type DiscoverDryRunReq struct {
+	Audience Audience `json:"audience"`
+	Limit    int32    `json:"limit"`
+}
+
+// ValidVersion reports whether v is a dotted numeric version such as "3.2" or "3.2.1".
+func ValidVersion(v string) bool {
+	if v == "" {
+		return false
+	}
+	for _, part := range strings.Split(v, ".") {
+		if _, err := strconv.Atoi(part); err != nil {
+			return false
+		}
+	}
+	return true
+}
+
+// CompareVersions compares dotted numeric versions, treating missing parts as 0.
+// It returns -1, 0 or 1.
+func CompareVersions(a, b string) int {
+	as, bs := strings.Split(a, "."), strings.Split(b, ".")
+	for i := 0; i < len(as) || i < len(bs); i++ {
+		var x, y int
+		if i < len(as) {
+			x, _ = strconv.Atoi(as[i])
+		}
+		if i < len(bs) {
+			y, _ = strconv.Atoi(bs[i])
+		}
+		switch {
+		case x < y:
+			return -1
+		case x > y:
+			return 1
+		}
+	}
+	return 0
+}
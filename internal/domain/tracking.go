@@ -0,0 +1,35 @@
+package domain
+
+import (
+	"time"
+)
+
+// Kinds of tracking events reported by the clients.
+const (
+	TrackingImpression = "impression"
+	TrackingClick      = "click"
+)
+
+// TrackingItemTypes lists the sections whose items are tracked.
+var TrackingItemTypes = []string{
+	ItemTypeArticle, ItemTypeCarousel, ItemTypeAnnouncement, ItemTypeQuickLink, ItemTypePoll, ItemTypeEvent, ItemTypeGroup,
+}
+
+type DiscoverTrackingEvent struct {
+	ItemType string `json:"itemType" binding:"required,oneof=article carousel announcement quicklink poll event group"`
+	ItemID   int64  `json:"itemId" binding:"required,min=1"`
+	Kind     string `json:"kind" binding:"required,oneof=impression click"`
+	// Variant is the variant key a carousel was served with, for its experiment statistics.
+	Variant string `json:"variant" binding:"omitempty,max=32"`
+	// OccurredAt is when the client saw or clicked the item; the server time is used
+	// when it is missing, in the future or older than the days the stats rollup rebuilds.
+	OccurredAt *time.Time `json:"occurredAt"`
+}
+
+type DiscoverTrackingReq struct {
+	Events []DiscoverTrackingEvent `json:"events" binding:"required,min=1,max=100,dive"`
+	// UserID, Platform and OperationID are taken from the caller's token and headers.
+	UserID      string `json:"-"`
+	Platform    string `json:"-"`
+	OperationID string `json:"-"`
+}
@@ -0,0 +1,12 @@
+package domain
+
+type DiscoverTrashFindReq struct {
+	Page  int32  `validate:"min=1"`
+	Limit int32  `validate:"min=1,max=100"`
+	Title string `json:"title"`
+}
+
+type DiscoverRestoreReq struct {
+	ID         int64  `json:"id" binding:"required"`
+	OperatedBy string `json:"-"`
+}
@@ -0,0 +1,33 @@
+package domain
+
+// Bounds of the trending articles strip.
+const (
+	DefaultTrendingLimit = 10
+	MaxTrendingLimit     = 50
+	// MaxTrendingWindowHours is how long article opens are kept for the trending scores.
+	MaxTrendingWindowHours = 168
+	// DefaultTrendingWindowHours is used when no valid window is configured.
+	DefaultTrendingWindowHours = 24
+)
+
+// TrendingWindows keeps the configured trending windows, in hours, that opens are kept
+// long enough for, dropping duplicates. The first window is the default of the endpoint.
+func TrendingWindows(hours []int) []int {
+	seen := make(map[int]struct{}, len(hours))
+	resp := make([]int, 0, len(hours))
+	for _, window := range hours {
+		if window <= 0 || window > MaxTrendingWindowHours {
+			continue
+		}
+		if _, ok := seen[window]; ok {
+			continue
+		}
+		seen[window] = struct{}{}
+		resp = append(resp, window)
+	}
+
+	if len(resp) == 0 {
+		return []int{DefaultTrendingWindowHours}
+	}
+	return resp
+}
@@ -0,0 +1,64 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/1nterdigital/aka-im-tools/log"
+)
+
+// Roller rebuilds the daily item statistics of a UTC day.
+type Roller interface {
+	Rollup(ctx context.Context, day time.Time) (err error)
+}
+
+// StatsRollup periodically rolls the tracking events up into the daily item statistics,
+// persisting the viewers counted in Redis along. Each run rebuilds the current day and
+// the lookback days before it, so that events inserted late still reach their day.
+type StatsRollup struct {
+	lookbackDays int
+	interval     time.Duration
+	roller       Roller
+}
+
+func NewStatsRollup(lookbackDays, intervalMinutes int, roller Roller) *StatsRollup {
+	if lookbackDays < 0 {
+		lookbackDays = 0
+	}
+	if intervalMinutes <= 0 {
+		intervalMinutes = 15
+	}
+
+	return &StatsRollup{
+		lookbackDays: lookbackDays,
+		interval:     time.Duration(intervalMinutes) * time.Minute,
+		roller:       roller,
+	}
+}
+
+// Run blocks until ctx is done.
+func (j *StatsRollup) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.rollup(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *StatsRollup) rollup(ctx context.Context) {
+	today := time.Now().UTC()
+	for i := j.lookbackDays; i >= 0; i-- {
+		day := today.AddDate(0, 0, -i)
+		err := j.roller.Rollup(ctx, day)
+		if err != nil {
+			log.ZError(ctx, "stats rollup failed", err, "day", day.Format(time.DateOnly))
+		}
+	}
+}
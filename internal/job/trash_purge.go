@@ -0,0 +1,67 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/1nterdigital/aka-im-tools/log"
+)
+
+// Purger permanently removes trashed items deleted before the given time.
+type Purger interface {
+	Purge(ctx context.Context, deletedBefore time.Time) (count int64, err error)
+}
+
+// TrashPurge periodically hard-deletes items that stayed in the trash longer than the retention.
+type TrashPurge struct {
+	retention time.Duration
+	interval  time.Duration
+	purgers   map[string]Purger
+}
+
+func NewTrashPurge(retentionDays, intervalMinutes int, purgers map[string]Purger) *TrashPurge {
+	if intervalMinutes <= 0 {
+		intervalMinutes = 60
+	}
+
+	return &TrashPurge{
+		retention: time.Duration(retentionDays) * 24 * time.Hour,
+		interval:  time.Duration(intervalMinutes) * time.Minute,
+		purgers:   purgers,
+	}
+}
+
+// Run blocks until ctx is done. A non-positive retention disables the purge.
+func (j *TrashPurge) Run(ctx context.Context) {
+	if j.retention <= 0 {
+		log.ZInfo(ctx, "trash purge disabled")
+		return
+	}
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.purge(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *TrashPurge) purge(ctx context.Context) {
+	deletedBefore := time.Now().Add(-j.retention)
+	for itemType, purger := range j.purgers {
+		count, err := purger.Purge(ctx, deletedBefore)
+		if err != nil {
+			log.ZError(ctx, "trash purge failed", err, "itemType", itemType)
+			continue
+		}
+		if count > 0 {
+			log.ZInfo(ctx, "trash purged", "itemType", itemType, "count", count)
+		}
+	}
+}
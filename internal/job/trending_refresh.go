@@ -0,0 +1,62 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"github.com/1nterdigital/aka-im-tools/log"
+)
+
+// Refresher recomputes the trending ranking of a window from the recorded opens.
+type Refresher interface {
+	Refresh(ctx context.Context, windowHours int, halfLife time.Duration) (err error)
+}
+
+// TrendingRefresh periodically recomputes the trending article rankings.
+type TrendingRefresh struct {
+	windows   []int
+	halfLife  time.Duration
+	interval  time.Duration
+	refresher Refresher
+}
+
+func NewTrendingRefresh(windows []int, halfLifeHours, intervalMinutes int, refresher Refresher) *TrendingRefresh {
+	if halfLifeHours <= 0 {
+		halfLifeHours = 12
+	}
+	if intervalMinutes <= 0 {
+		intervalMinutes = 10
+	}
+
+	return &TrendingRefresh{
+		windows:   windows,
+		halfLife:  time.Duration(halfLifeHours) * time.Hour,
+		interval:  time.Duration(intervalMinutes) * time.Minute,
+		refresher: refresher,
+	}
+}
+
+// Run blocks until ctx is done.
+func (j *TrendingRefresh) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		j.refresh(ctx)
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (j *TrendingRefresh) refresh(ctx context.Context) {
+	for _, window := range j.windows {
+		err := j.refresher.Refresh(ctx, window, j.halfLife)
+		if err != nil {
+			log.ZError(ctx, "trending refresh failed", err, "windowHours", window)
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"time"
+)
+
+// CarouselVariant is one alternative of a slide; its empty fields keep the slide's.
+type CarouselVariant struct {
+	Key      string `json:"key"`
+	Title    string `json:"title,omitempty"`
+	ImageURL string `json:"imageUrl,omitempty"`
+	LinkURL  string `json:"linkUrl,omitempty"`
+	Weight   int    `json:"weight"`
+}
+
+// CarouselExperiment splits the users seeing a slide between its variants, in proportion
+// to their weights. Its ID changes whenever the variants do, which reassigns the users
+// and restarts the statistics from StartedAt.
+type CarouselExperiment struct {
+	ID        string            `json:"id"`
+	StartedAt time.Time         `json:"startedAt"`
+	Variants  []CarouselVariant `json:"variants"`
+}
+
+// Assign returns the variant of a user. The user ID is hashed with the experiment ID, so
+// a user keeps their variant for the whole experiment and is reshuffled by the next one.
+func (e *CarouselExperiment) Assign(userID string) (CarouselVariant, bool) {
+	total := 0
+	for _, variant := range e.Variants {
+		total += variant.Weight
+	}
+	if total <= 0 {
+		return CarouselVariant{}, false
+	}
+
+	hash := fnv.New64a()
+	_, _ = hash.Write([]byte(e.ID + ":" + userID))
+	point := int(hash.Sum64() % uint64(total))
+	for _, variant := range e.Variants {
+		if point < variant.Weight {
+			return variant, true
+		}
+		point -= variant.Weight
+	}
+	return CarouselVariant{}, false
+}
+
+// Variant returns the variant of the experiment with the given key.
+func (e *CarouselExperiment) Variant(key string) (CarouselVariant, bool) {
+	for _, variant := range e.Variants {
+		if variant.Key == key {
+			return variant, true
+		}
+	}
+	return CarouselVariant{}, false
+}
+
+func (e *CarouselExperiment) Value() (driver.Value, error) {
+	if e == nil || len(e.Variants) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(e)
+}
+
+func (e *CarouselExperiment) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*e = CarouselExperiment{}
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported carousel experiment type %T", value)
+	}
+	return json.Unmarshal(raw, e)
+}
@@ -0,0 +1,58 @@
+package entity
+
+import (
+	"time"
+)
+
+// ContentBase holds the columns shared by every discover section: display fields,
+// targeting, the editorial status, the publish window, the owning campaign and the
+// audit trail.
+type ContentBase struct {
+	ID          int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Title       string     `gorm:"column:title" json:"title"`
+	ImageURL    string     `gorm:"column:image_url" json:"imageUrl"`
+	LinkURL     string     `gorm:"column:link_url" json:"linkUrl"`
+	Locales     Locales    `gorm:"column:locales;type:json" json:"locales,omitempty"`
+	Targeting   *Targeting `gorm:"column:targeting;type:json" json:"targeting,omitempty"`
+	Status      string     `gorm:"column:status;type:varchar(32);not null;index; default:draft" json:"status"`
+	Position    *int       `gorm:"column:position" json:"position"`
+	PublishAt   *time.Time `gorm:"column:publish_at;index; default:null" json:"publishAt"`
+	ExpireAt    *time.Time `gorm:"column:expire_at;index; default:null" json:"expireAt"`
+	CampaignID  *int64     `gorm:"column:campaign_id;index; default:null" json:"campaignId"`
+	CreatedAt   time.Time  `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy   string     `gorm:"column:created_by" json:"createdBy"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+	UpdatedBy   string     `gorm:"column:updated_by" json:"updatedBy"`
+	DeletedAt   *time.Time `gorm:"column:deleted_at; default:null" json:"deletedAt"`
+	DeletedBy   string     `gorm:"column:deleted_by" json:"deletedBy"`
+	SubmittedBy string     `gorm:"column:submitted_by" json:"submittedBy"`
+	ReviewedBy  string     `gorm:"column:reviewed_by" json:"reviewedBy"`
+}
+
+// Content is implemented by the pointer of every section model, which embeds ContentBase.
+type Content interface {
+	TableName() string
+	Base() *ContentBase
+	Localize(prefs []string)
+}
+
+// ContentPtr constrains the type parameters of the generic section code to a
+// section model T whose pointer is Content.
+type ContentPtr[T any] interface {
+	*T
+	Content
+}
+
+// Base returns the shared columns of a section item.
+func (b *ContentBase) Base() *ContentBase {
+	return b
+}
+
+// Localize replaces the display fields with the best matching locale and drops
+// the other variants from the item.
+func (b *ContentBase) Localize(prefs []string) {
+	if localized, ok := b.Locales.Pick(prefs); ok {
+		localized.apply(&b.Title, &b.ImageURL, &b.LinkURL)
+	}
+	b.Locales = nil
+}
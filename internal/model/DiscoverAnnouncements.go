@@ -0,0 +1,13 @@
+package entity
+
+// DiscoverAnnouncements is a notice shown at the top of the discover page. Its title is
+// the notice text and its link, when set, the target of the call-to-action button.
+type DiscoverAnnouncements struct {
+	ContentBase
+	Severity string `gorm:"column:severity;type:varchar(16);not null; default:info" json:"severity"`
+	CTALabel string `gorm:"column:cta_label" json:"ctaLabel"`
+}
+
+func (DiscoverAnnouncements) TableName() string {
+	return "announcements"
+}
@@ -1,22 +1,14 @@
 package entity
 
-import (
-	"time"
-)
-
 type DiscoverArticles struct {
-	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
-	Title     string     `gorm:"column:title" json:"title"`
-	ImageURL  string     `gorm:"column:image_url" json:"imageUrl"`
-	LinkURL   string     `gorm:"column:link_url" json:"linkUrl"`
-	IsActive  bool       `gorm:"column:is_active;not null; default:true" json:"isActive"`
-	Position  *int       `gorm:"column:position" json:"position"`
-	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
-	CreatedBy string     `gorm:"column:created_by" json:"createdBy"`
-	UpdatedAt time.Time  `gorm:"column:updated_at" json:"updatedAt"`
-	UpdatedBy string     `gorm:"column:updated_by" json:"updatedBy"`
-	DeletedAt *time.Time `gorm:"column:deleted_at; default:null" json:"deletedAt"`
-	DeletedBy string     `gorm:"column:deleted_by" json:"deletedBy"`
+	ContentBase
+	Body       string `gorm:"column:body;type:mediumtext" json:"body,omitempty"`
+	BodyHTML   string `gorm:"column:body_html;type:mediumtext" json:"bodyHtml,omitempty"`
+	CategoryID *int64 `gorm:"column:category_id;index; default:null" json:"categoryId"`
+	Tags       Tags   `gorm:"column:tags;type:json" json:"tags"`
+
+	// Related is filled by the public get with the suggested articles.
+	Related []*DiscoverArticles `gorm:"-" json:"related,omitempty"`
 }
 
 func (DiscoverArticles) TableName() string {
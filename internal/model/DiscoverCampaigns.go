@@ -0,0 +1,39 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverCampaigns bundles discover items that go live and end together. The items
+// point at their campaign through ContentBase.CampaignID; while the campaign is paused
+// or outside its window none of them is visible, whatever their own status.
+type DiscoverCampaigns struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Name      string     `gorm:"column:name" json:"name"`
+	Paused    bool       `gorm:"column:paused;not null;default:false" json:"paused"`
+	PublishAt *time.Time `gorm:"column:publish_at;index; default:null" json:"publishAt"`
+	ExpireAt  *time.Time `gorm:"column:expire_at;index; default:null" json:"expireAt"`
+	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy string     `gorm:"column:created_by" json:"createdBy"`
+	UpdatedAt time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+	UpdatedBy string     `gorm:"column:updated_by" json:"updatedBy"`
+	DeletedAt *time.Time `gorm:"column:deleted_at; default:null" json:"deletedAt"`
+	DeletedBy string     `gorm:"column:deleted_by" json:"deletedBy"`
+
+	// Status and Items are filled by the admin API from the member items.
+	Status string          `gorm:"-" json:"status"`
+	Items  []*CampaignItem `gorm:"-" json:"items"`
+}
+
+func (DiscoverCampaigns) TableName() string {
+	return "campaigns"
+}
+
+// CampaignItem is the summary of one member of a campaign.
+type CampaignItem struct {
+	ItemType   string `gorm:"-" json:"itemType"`
+	ID         int64  `gorm:"column:id" json:"id"`
+	Title      string `gorm:"column:title" json:"title"`
+	Status     string `gorm:"column:status" json:"status"`
+	CampaignID int64  `gorm:"column:campaign_id" json:"-"`
+}
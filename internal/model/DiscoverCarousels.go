@@ -1,24 +1,41 @@
 package entity
 
-import (
-	"time"
-)
-
+// DiscoverCarousels is a slide of the discover carousel. While it runs an experiment,
+// each user is shown one of its variants.
 type DiscoverCarousels struct {
-	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
-	Title     string     `gorm:"column:title" json:"title"`
-	ImageURL  string     `gorm:"column:image_url" json:"imageUrl"`
-	LinkURL   string     `gorm:"column:link_url" json:"linkUrl"`
-	IsActive  bool       `gorm:"column:is_active;not null; default:true" json:"isActive"`
-	Position  *int       `gorm:"column:position" json:"position"`
-	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
-	CreatedBy string     `gorm:"column:created_by" json:"createdBy"`
-	UpdatedAt time.Time  `gorm:"column:updated_at" json:"updatedAt"`
-	UpdatedBy string     `gorm:"column:updated_by" json:"updatedBy"`
-	DeletedAt *time.Time `gorm:"column:deleted_at; default:null" json:"deletedAt"`
-	DeletedBy string     `gorm:"column:deleted_by" json:"deletedBy"`
+	ContentBase
+	Experiment *CarouselExperiment `gorm:"column:experiment;type:json" json:"experiment,omitempty"`
+	// Variant is the key of the variant served to the caller; it is not stored.
+	Variant string `gorm:"-" json:"variant,omitempty"`
 }
 
 func (DiscoverCarousels) TableName() string {
 	return "carousels"
 }
+
+// Present shows the slide as the variant assigned to userID and hides the experiment,
+// returning the variant key. Callers without a user ID see the slide itself.
+func (c *DiscoverCarousels) Present(userID string) string {
+	experiment := c.Experiment
+	c.Experiment = nil
+	if experiment == nil || userID == "" {
+		return ""
+	}
+
+	variant, ok := experiment.Assign(userID)
+	if !ok {
+		return ""
+	}
+
+	if variant.Title != "" {
+		c.Title = variant.Title
+	}
+	if variant.ImageURL != "" {
+		c.ImageURL = variant.ImageURL
+	}
+	if variant.LinkURL != "" {
+		c.LinkURL = variant.LinkURL
+	}
+	c.Variant = variant.Key
+	return variant.Key
+}
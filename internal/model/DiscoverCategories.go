@@ -0,0 +1,38 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/1nterdigital/aka-im-discover/pkg/helper"
+)
+
+type DiscoverCategories struct {
+	ID        int64      `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Slug      string     `gorm:"column:slug;type:varchar(64);index" json:"slug"`
+	Name      string     `gorm:"column:name" json:"name"`
+	IconURL   string     `gorm:"column:icon_url" json:"iconUrl"`
+	Locales   Locales    `gorm:"column:locales;type:json" json:"locales,omitempty"`
+	Position  *int       `gorm:"column:position" json:"position"`
+	CreatedAt time.Time  `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy string     `gorm:"column:created_by" json:"createdBy"`
+	UpdatedAt time.Time  `gorm:"column:updated_at" json:"updatedAt"`
+	UpdatedBy string     `gorm:"column:updated_by" json:"updatedBy"`
+	DeletedAt *time.Time `gorm:"column:deleted_at; default:null" json:"deletedAt"`
+	DeletedBy string     `gorm:"column:deleted_by" json:"deletedBy"`
+
+	// ArticleCount is filled by the public listing with the number of live articles.
+	ArticleCount int64 `gorm:"->;-:migration" json:"articleCount"`
+}
+
+func (DiscoverCategories) TableName() string {
+	return "categories"
+}
+
+// Localize replaces the name and icon with the best matching locale and drops the other variants.
+func (c *DiscoverCategories) Localize(prefs []string) {
+	if localized, ok := c.Locales.Pick(prefs); ok {
+		c.Name = helper.ChainString(localized.Title, c.Name)
+		c.IconURL = helper.ChainString(localized.ImageURL, c.IconURL)
+	}
+	c.Locales = nil
+}
@@ -0,0 +1,17 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverEventRSVPs records that a user joined an event.
+type DiscoverEventRSVPs struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	EventID   int64     `gorm:"column:event_id;not null;uniqueIndex:idx_event_rsvps_event_user" json:"eventId"`
+	UserID    string    `gorm:"column:user_id;type:varchar(64);not null;uniqueIndex:idx_event_rsvps_event_user;index" json:"userId"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+func (DiscoverEventRSVPs) TableName() string {
+	return "event_rsvps"
+}
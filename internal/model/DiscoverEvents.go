@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverEvents is a community event; the link is its join link for online events.
+// RSVPCount is only changed by RSVPs and cancellations, never by edits or rollbacks.
+type DiscoverEvents struct {
+	ContentBase
+	Location  string    `gorm:"column:location" json:"location"`
+	StartsAt  time.Time `gorm:"column:starts_at;not null;index" json:"startsAt"`
+	EndsAt    time.Time `gorm:"column:ends_at;not null" json:"endsAt"`
+	Capacity  *int      `gorm:"column:capacity" json:"capacity"`
+	RSVPCount int       `gorm:"column:rsvp_count;not null;default:0" json:"rsvpCount"`
+}
+
+func (DiscoverEvents) TableName() string {
+	return "events"
+}
+
+// HasEnded reports whether the event is over at now.
+func (e *DiscoverEvents) HasEnded(now time.Time) bool {
+	return !now.Before(e.EndsAt)
+}
@@ -0,0 +1,21 @@
+package entity
+
+// DiscoverFeaturedGroups pins an IM group to the discover page. The title, when set,
+// overrides the group name; Group is filled from the IM by the public finds.
+type DiscoverFeaturedGroups struct {
+	ContentBase
+	GroupID string   `gorm:"column:group_id;type:varchar(64);not null;index" json:"groupId"`
+	Group   *IMGroup `gorm:"-" json:"group,omitempty"`
+}
+
+func (DiscoverFeaturedGroups) TableName() string {
+	return "featured_groups"
+}
+
+// IMGroup is the live state of an IM group shown on the discover page.
+type IMGroup struct {
+	GroupID     string `json:"groupId"`
+	Name        string `json:"name"`
+	FaceURL     string `json:"faceUrl"`
+	MemberCount int64  `json:"memberCount"`
+}
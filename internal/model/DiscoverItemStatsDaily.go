@@ -0,0 +1,24 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverItemStatsDaily is the rollup of the tracking events of one item over one UTC day.
+type DiscoverItemStatsDaily struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Day         time.Time `gorm:"column:day;type:date;not null;uniqueIndex:idx_item_stats_day_item" json:"day"`
+	ItemType    string    `gorm:"column:item_type;type:varchar(32);not null;uniqueIndex:idx_item_stats_day_item" json:"itemType"`
+	ItemID      int64     `gorm:"column:item_id;not null;uniqueIndex:idx_item_stats_day_item;index" json:"itemId"`
+	Impressions int64     `gorm:"column:impressions;not null;default:0" json:"impressions"`
+	Clicks      int64     `gorm:"column:clicks;not null;default:0" json:"clicks"`
+	UniqueUsers int64     `gorm:"column:unique_users;not null;default:0" json:"uniqueUsers"`
+	// Viewers is the approximate count of distinct users persisted from Redis.
+	Viewers   int64     `gorm:"column:viewers;not null;default:0" json:"viewers"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"createdAt"`
+	UpdatedAt time.Time `gorm:"column:updated_at" json:"updatedAt"`
+}
+
+func (DiscoverItemStatsDaily) TableName() string {
+	return "item_stats_daily"
+}
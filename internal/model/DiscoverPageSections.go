@@ -0,0 +1,20 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverPageSections is one row of the discover page layout: the section shown at
+// Position, with at most ItemLimit of its items.
+type DiscoverPageSections struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	Section   string    `gorm:"column:section;type:varchar(32);uniqueIndex" json:"section"`
+	Position  int       `gorm:"column:position" json:"position"`
+	ItemLimit int32     `gorm:"column:item_limit" json:"limit"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy string    `gorm:"column:created_by" json:"createdBy"`
+}
+
+func (DiscoverPageSections) TableName() string {
+	return "page_sections"
+}
@@ -0,0 +1,18 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverPollVotes is the vote of one user on a poll, holding every option picked.
+type DiscoverPollVotes struct {
+	ID        int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	PollID    int64     `gorm:"column:poll_id;not null;uniqueIndex:idx_poll_votes_poll_user" json:"pollId"`
+	UserID    string    `gorm:"column:user_id;type:varchar(64);not null;uniqueIndex:idx_poll_votes_poll_user" json:"userId"`
+	OptionIDs OptionIDs `gorm:"column:option_ids;type:json" json:"optionIds"`
+	CreatedAt time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+func (DiscoverPollVotes) TableName() string {
+	return "poll_votes"
+}
@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverPolls is a poll of the discover page; the title is its question. The publish
+// window decides when it is shown, OpensAt and ClosesAt when it takes votes.
+type DiscoverPolls struct {
+	ContentBase
+	Options     PollOptions `gorm:"column:options;type:json" json:"options"`
+	MultiChoice bool        `gorm:"column:multi_choice;not null;default:false" json:"multiChoice"`
+	OpensAt     *time.Time  `gorm:"column:opens_at" json:"opensAt"`
+	ClosesAt    *time.Time  `gorm:"column:closes_at" json:"closesAt"`
+}
+
+func (DiscoverPolls) TableName() string {
+	return "polls"
+}
+
+// Option returns the option of the poll with the given ID.
+func (p *DiscoverPolls) Option(id int64) (PollOption, bool) {
+	for _, option := range p.Options {
+		if option.ID == id {
+			return option, true
+		}
+	}
+	return PollOption{}, false
+}
+
+// IsOpen reports whether the poll takes votes at now.
+func (p *DiscoverPolls) IsOpen(now time.Time) bool {
+	if p.OpensAt != nil && now.Before(*p.OpensAt) {
+		return false
+	}
+	return p.ClosesAt == nil || now.Before(*p.ClosesAt)
+}
@@ -0,0 +1,13 @@
+package entity
+
+// DiscoverQuickLinks is a shortcut icon of the discover grid: the title is its label,
+// the image its icon and the link the mini-app or page it opens. Links are shown
+// grouped by GroupName and ordered by position inside a group.
+type DiscoverQuickLinks struct {
+	ContentBase
+	GroupName string `gorm:"column:group_name;type:varchar(64);not null;default:'';index" json:"group"`
+}
+
+func (DiscoverQuickLinks) TableName() string {
+	return "quick_links"
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type DiscoverRevisions struct {
+	ID        int64           `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ItemType  string          `gorm:"column:item_type;type:varchar(32);uniqueIndex:uk_revisions_item" json:"itemType"`
+	ItemID    int64           `gorm:"column:item_id;uniqueIndex:uk_revisions_item" json:"itemId"`
+	Revision  int             `gorm:"column:revision;uniqueIndex:uk_revisions_item" json:"revision"`
+	Action    string          `gorm:"column:action;type:varchar(32)" json:"action"`
+	Snapshot  json.RawMessage `gorm:"column:snapshot;type:json" json:"snapshot"`
+	Note      string          `gorm:"column:note" json:"note"`
+	CreatedAt time.Time       `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy string          `gorm:"column:created_by" json:"createdBy"`
+}
+
+func (DiscoverRevisions) TableName() string {
+	return "revisions"
+}
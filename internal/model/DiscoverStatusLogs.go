@@ -0,0 +1,21 @@
+package entity
+
+import (
+	"time"
+)
+
+type DiscoverStatusLogs struct {
+	ID         int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ItemType   string    `gorm:"column:item_type;type:varchar(32);index:idx_status_logs_item" json:"itemType"`
+	ItemID     int64     `gorm:"column:item_id;index:idx_status_logs_item" json:"itemId"`
+	Action     string    `gorm:"column:action;type:varchar(32)" json:"action"`
+	FromStatus string    `gorm:"column:from_status;type:varchar(32)" json:"fromStatus"`
+	ToStatus   string    `gorm:"column:to_status;type:varchar(32)" json:"toStatus"`
+	Note       string    `gorm:"column:note" json:"note"`
+	CreatedAt  time.Time `gorm:"column:created_at" json:"createdAt"`
+	CreatedBy  string    `gorm:"column:created_by" json:"createdBy"`
+}
+
+func (DiscoverStatusLogs) TableName() string {
+	return "status_logs"
+}
@@ -0,0 +1,23 @@
+package entity
+
+import (
+	"time"
+)
+
+// DiscoverTrackingEvents is one impression or click of a discover item reported by a client.
+type DiscoverTrackingEvents struct {
+	ID          int64     `gorm:"column:id;primaryKey;autoIncrement" json:"id"`
+	ItemType    string    `gorm:"column:item_type;type:varchar(32);not null;index:idx_tracking_item,priority:1" json:"itemType"`
+	ItemID      int64     `gorm:"column:item_id;not null;index:idx_tracking_item,priority:2" json:"itemId"`
+	Kind        string    `gorm:"column:kind;type:varchar(16);not null" json:"kind"`
+	Variant     string    `gorm:"column:variant;type:varchar(32)" json:"variant"`
+	UserID      string    `gorm:"column:user_id;type:varchar(64)" json:"userId"`
+	Platform    string    `gorm:"column:platform;type:varchar(32)" json:"platform"`
+	OperationID string    `gorm:"column:operation_id;type:varchar(64)" json:"operationId"`
+	OccurredAt  time.Time `gorm:"column:occurred_at;not null;index:idx_tracking_item,priority:3;index:idx_tracking_time" json:"occurredAt"`
+	CreatedAt   time.Time `gorm:"column:created_at" json:"createdAt"`
+}
+
+func (DiscoverTrackingEvents) TableName() string {
+	return "tracking_events"
+}
@@ -0,0 +1,78 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/1nterdigital/aka-im-discover/pkg/helper"
+)
+
+// Localized holds the per-locale overrides of an item's display fields.
+// Empty fields fall back to the item's own value.
+type Localized struct {
+	Title    string `json:"title,omitempty"`
+	ImageURL string `json:"imageUrl,omitempty"`
+	LinkURL  string `json:"linkUrl,omitempty"`
+}
+
+// Locales maps a normalized locale tag (e.g. "en", "zh-cn") to its overrides.
+type Locales map[string]Localized
+
+func (l Locales) Value() (driver.Value, error) {
+	if len(l) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(l)
+}
+
+func (l *Locales) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*l = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported locales type %T", value)
+	}
+	return json.Unmarshal(raw, l)
+}
+
+// Pick returns the overrides for the first preferred locale that matches exactly,
+// by its base language ("zh-tw" matches "zh") or by a sibling sharing that base
+// ("zh" and "zh-tw" match "zh-cn"; the first one in order when several do).
+func (l Locales) Pick(prefs []string) (Localized, bool) {
+	var keys []string
+	for _, pref := range prefs {
+		if localized, ok := l[pref]; ok {
+			return localized, true
+		}
+		base, _, _ := strings.Cut(pref, "-")
+		if localized, ok := l[base]; ok {
+			return localized, true
+		}
+
+		if keys == nil {
+			keys = slices.Sorted(maps.Keys(l))
+		}
+		for _, key := range keys {
+			if strings.HasPrefix(key, base+"-") {
+				return l[key], true
+			}
+		}
+	}
+	return Localized{}, false
+}
+
+func (l Localized) apply(title, imageURL, linkURL *string) {
+	*title = helper.ChainString(l.Title, *title)
+	*imageURL = helper.ChainString(l.ImageURL, *imageURL)
+	*linkURL = helper.ChainString(l.LinkURL, *linkURL)
+}
@@ -0,0 +1,68 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// PollOption is one answer of a poll. IDs are assigned on creation and stay stable,
+// votes refer to them.
+type PollOption struct {
+	ID    int64  `json:"id"`
+	Label string `json:"label"`
+}
+
+// PollOptions is the list of answers of a poll stored as a JSON array.
+type PollOptions []PollOption
+
+func (o PollOptions) Value() (driver.Value, error) {
+	if o == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]PollOption(o))
+	return string(b), err
+}
+
+func (o *PollOptions) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported poll options type %T", value)
+	}
+	return json.Unmarshal(raw, (*[]PollOption)(o))
+}
+
+// OptionIDs is the list of poll options picked by a vote, stored as a JSON array.
+type OptionIDs []int64
+
+func (o OptionIDs) Value() (driver.Value, error) {
+	if o == nil {
+		return "[]", nil
+	}
+	b, err := json.Marshal([]int64(o))
+	return string(b), err
+}
+
+func (o *OptionIDs) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*o = nil
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported option ids type %T", value)
+	}
+	return json.Unmarshal(raw, (*[]int64)(o))
+}
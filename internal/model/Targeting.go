@@ -0,0 +1,44 @@
+package entity
+
+import (
+	"database/sql/driver"
+	"encoding/json"
+	"fmt"
+)
+
+// Targeting restricts who sees an item. Empty fields do not restrict.
+type Targeting struct {
+	Platforms     []string `json:"platforms,omitempty"`
+	MinAppVersion string   `json:"minAppVersion,omitempty"`
+	MaxAppVersion string   `json:"maxAppVersion,omitempty"`
+	Regions       []string `json:"regions,omitempty"`
+	UserTypes     []int32  `json:"userTypes,omitempty"`
+}
+
+func (t *Targeting) IsEmpty() bool {
+	return t == nil || (len(t.Platforms) == 0 && t.MinAppVersion == "" && t.MaxAppVersion == "" &&
+		len(t.Regions) == 0 && len(t.UserTypes) == 0)
+}
+
+func (t *Targeting) Value() (driver.Value, error) {
+	if t.IsEmpty() {
+		return nil, nil
+	}
+	return json.Marshal(t)
+}
+
+func (t *Targeting) Scan(value interface{}) error {
+	var raw []byte
+	switch v := value.(type) {
+	case nil:
+		*t = Targeting{}
+		return nil
+	case []byte:
+		raw = v
+	case string:
+		raw = []byte(v)
+	default:
+		return fmt.Errorf("unsupported targeting type %T", value)
+	}
+	return json.Unmarshal(raw, t)
+}
@@ -0,0 +1,21 @@
+package announcements
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+)
+
+type Repository = section.Repository[*model.DiscoverAnnouncements]
+
+func New(db *gorm.DB) Repository {
+	return section.New[model.DiscoverAnnouncements](db, section.Options[*model.DiscoverAnnouncements]{
+		ItemType: domain.ItemTypeAnnouncement,
+		Columns:  []string{"severity", "cta_label"},
+		Filters: []section.Filter{
+			{Param: "severity", Where: "severity = ?"},
+		},
+	})
+}
@@ -1,19 +1,51 @@
 package articles
 
 import (
-	"context"
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
 
 	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/categories"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+	"github.com/1nterdigital/aka-im-tools/errs"
 )
 
-type Repository interface {
-	Create(ctx context.Context, item *model.DiscoverArticles) (err error)
-	Find(
-		ctx context.Context, req *domain.DiscoverArticlesFindReq,
-	) (resp []*model.DiscoverArticles, count int64, err error)
-	Delete(ctx context.Context, id int64, deletedBy string) (err error)
-	Edit(
-		ctx context.Context, article *model.DiscoverArticles,
-	) (resp *model.DiscoverArticles, err error)
+type Repository = section.Repository[*model.DiscoverArticles]
+
+func New(db *gorm.DB) Repository {
+	return section.New[model.DiscoverArticles](db, section.Options[*model.DiscoverArticles]{
+		ItemType:    domain.ItemTypeArticle,
+		UniqueTitle: true,
+		Columns:     []string{"body", "body_html", "category_id", "tags"},
+		// Bodies are only served by Get; lists stay light.
+		ListOmit: []string{"body", "body_html"},
+		Filters: []section.Filter{
+			{Param: "categoryId", Where: "category_id = ?", Normalize: parseCategoryID},
+			{Param: "tag", Where: "JSON_CONTAINS(tags, JSON_QUOTE(?))", Normalize: normalizeTag},
+		},
+		Validate: validate,
+	})
+}
+
+// validate checks that the category of an article still exists.
+func validate(tx *gorm.DB, article *model.DiscoverArticles) error {
+	if article.CategoryID == nil {
+		return nil
+	}
+	return categories.Exists(tx, *article.CategoryID)
+}
+
+func parseCategoryID(value string) (interface{}, error) {
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil || id < 0 {
+		return nil, errs.ErrArgs.WrapMsg("invalid categoryId query param")
+	}
+	return id, nil
+}
+
+func normalizeTag(value string) (interface{}, error) {
+	return strings.ToLower(value), nil
 }
@@ -0,0 +1,34 @@
+package campaigns
+
+import (
+	"context"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	Create(ctx context.Context, campaign *model.DiscoverCampaigns) (err error)
+	Find(
+		ctx context.Context, req *domain.DiscoverCampaignsFindReq,
+	) (resp []*model.DiscoverCampaigns, count int64, err error)
+	Get(ctx context.Context, id int64) (resp *model.DiscoverCampaigns, err error)
+	// Edit changes the non-empty fields of campaign; a window bound is only removed when
+	// its clear flag is set, and setting and clearing it at once is refused.
+	Edit(
+		ctx context.Context, campaign *model.DiscoverCampaigns, clearPublishAt, clearExpireAt bool,
+	) (resp *model.DiscoverCampaigns, err error)
+	// Delete removes a campaign and releases its items, including the ones in the trash.
+	Delete(ctx context.Context, id int64, deletedBy string) (err error)
+	// SetPaused flips the pause flag, which hides or shows all items of the campaign at once.
+	SetPaused(
+		ctx context.Context, id int64, paused bool, operatedBy string,
+	) (resp *model.DiscoverCampaigns, err error)
+	// AddItems moves items into a campaign; their own publish window is cleared so that
+	// the campaign's applies alone.
+	AddItems(ctx context.Context, req *domain.DiscoverCampaignItemsReq) (err error)
+	// RemoveItems takes items out of a campaign; they keep the campaign's window as their own.
+	RemoveItems(ctx context.Context, req *domain.DiscoverCampaignItemsReq) (err error)
+	// Items lists the non-deleted members of the given campaigns, by campaign id.
+	Items(ctx context.Context, ids []int64) (resp map[int64][]*model.CampaignItem, err error)
+}
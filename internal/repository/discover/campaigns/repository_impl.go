@@ -0,0 +1,450 @@
+package campaigns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// itemTables maps the sections listed in domain.CampaignItemTypes to their tables.
+var itemTables = map[string]string{
+	domain.ItemTypeCarousel: model.DiscoverCarousels{}.TableName(),
+	domain.ItemTypeArticle:  model.DiscoverArticles{}.TableName(),
+}
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Create(ctx context.Context, campaign *model.DiscoverCampaigns) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("name", campaign.Name),
+		attribute.String("createdBy", campaign.CreatedBy),
+	)
+
+	return r.db.WithContext(ctx).Create(campaign).Error
+}
+
+func (r *repositoryImpl) Find(
+	ctx context.Context, req *domain.DiscoverCampaignsFindReq,
+) (resp []*model.DiscoverCampaigns, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("name", req.Name),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+	)
+
+	query := r.db.WithContext(ctx).
+		Model(&model.DiscoverCampaigns{}).
+		Where("deleted_at IS NULL")
+	if req.Name != "" {
+		query = query.Where("name LIKE ?", "%"+req.Name+"%")
+	}
+
+	err = query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		return []*model.DiscoverCampaigns{}, 0, nil
+	}
+
+	err = query.Order("id DESC").
+		Limit(int(req.Limit)).
+		Offset(int((req.Page - 1) * req.Limit)).
+		Find(&resp).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp, count, nil
+}
+
+func (r *repositoryImpl) Get(ctx context.Context, id int64) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("id", id))
+
+	var campaign model.DiscoverCampaigns
+	err = r.db.WithContext(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&campaign).Error
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return &campaign, nil
+}
+
+func (r *repositoryImpl) Edit(
+	ctx context.Context, campaign *model.DiscoverCampaigns, clearPublishAt, clearExpireAt bool,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", campaign.ID),
+		attribute.String("updatedBy", campaign.UpdatedBy),
+		attribute.Bool("clearPublishAt", clearPublishAt),
+		attribute.Bool("clearExpireAt", clearExpireAt),
+	)
+
+	if clearPublishAt && campaign.PublishAt != nil {
+		return nil, errs.ErrArgs.WrapMsg("publishAt cannot be set and cleared at once")
+	}
+	if clearExpireAt && campaign.ExpireAt != nil {
+		return nil, errs.ErrArgs.WrapMsg("expireAt cannot be set and cleared at once")
+	}
+
+	var item model.DiscoverCampaigns
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = lock(tx, campaign.ID, &item)
+		if err != nil {
+			return err
+		}
+
+		updates := map[string]interface{}{
+			"updated_by": campaign.UpdatedBy,
+		}
+
+		if campaign.Name != "" {
+			updates["name"] = campaign.Name
+		}
+
+		// A nil bound with its clear flag writes NULL.
+		publishAt, expireAt := item.PublishAt, item.ExpireAt
+		if campaign.PublishAt != nil || clearPublishAt {
+			publishAt = campaign.PublishAt
+			updates["publish_at"] = campaign.PublishAt
+		}
+		if campaign.ExpireAt != nil || clearExpireAt {
+			expireAt = campaign.ExpireAt
+			updates["expire_at"] = campaign.ExpireAt
+		}
+		if !domain.ValidSchedule(publishAt, expireAt) {
+			return errs.ErrArgs.WrapMsg("expireAt must be after publishAt")
+		}
+
+		return tx.Model(&item).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repositoryImpl) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item model.DiscoverCampaigns
+		err = lock(tx, id, &item)
+		if err != nil {
+			return err
+		}
+
+		err = release(tx, &item, nil, deletedBy)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&item).Updates(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+		}).Error
+	})
+	return err
+}
+
+func (r *repositoryImpl) SetPaused(
+	ctx context.Context, id int64, paused bool, operatedBy string,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", id),
+		attribute.Bool("paused", paused),
+		attribute.String("operatedBy", operatedBy),
+	)
+
+	// The items read the flag through the schedule scope, so this single row update
+	// hides or shows all of them in the same instant.
+	var item model.DiscoverCampaigns
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = lock(tx, id, &item)
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&item).Updates(map[string]interface{}{
+			"paused":     paused,
+			"updated_by": operatedBy,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repositoryImpl) AddItems(ctx context.Context, req *domain.DiscoverCampaignItemsReq) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", req.ID),
+		attribute.Int("count", len(req.Items)),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var campaign model.DiscoverCampaigns
+		err = lock(tx, req.ID, &campaign)
+		if err != nil {
+			return err
+		}
+
+		for _, ref := range req.Items {
+			table, ok := itemTables[ref.ItemType]
+			if !ok {
+				return errs.ErrArgs.WrapMsg("items of type " + ref.ItemType + " cannot join a campaign")
+			}
+
+			var member struct {
+				ID         int64
+				CampaignID *int64
+			}
+			err = tx.Table(table).
+				Clauses(clause.Locking{Strength: "UPDATE"}).
+				Select("id, campaign_id").
+				Where("id = ? AND deleted_at IS NULL", ref.ItemID).
+				Take(&member).Error
+			if err != nil {
+				if errors.Is(err, gorm.ErrRecordNotFound) {
+					return eerrs.ErrItemNotFound.WrapMsg(fmt.Sprintf("%s %d not found", ref.ItemType, ref.ItemID))
+				}
+				return err
+			}
+			if member.CampaignID != nil && *member.CampaignID != req.ID {
+				return eerrs.ErrInCampaign.WrapMsg(
+					fmt.Sprintf("%s %d already belongs to campaign %d", ref.ItemType, ref.ItemID, *member.CampaignID))
+			}
+
+			err = tx.Table(table).
+				Where("id = ?", ref.ItemID).
+				Updates(map[string]interface{}{
+					"campaign_id": req.ID,
+					"publish_at":  nil,
+					"expire_at":   nil,
+					"updated_by":  req.OperatedBy,
+					"updated_at":  time.Now(),
+				}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	return err
+}
+
+func (r *repositoryImpl) RemoveItems(ctx context.Context, req *domain.DiscoverCampaignItemsReq) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", req.ID),
+		attribute.Int("count", len(req.Items)),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var campaign model.DiscoverCampaigns
+		err = lock(tx, req.ID, &campaign)
+		if err != nil {
+			return err
+		}
+
+		return release(tx, &campaign, req.Items, req.OperatedBy)
+	})
+	return err
+}
+
+func (r *repositoryImpl) Items(ctx context.Context, ids []int64) (resp map[int64][]*model.CampaignItem, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("count", len(ids)))
+
+	resp = make(map[int64][]*model.CampaignItem, len(ids))
+	if len(ids) == 0 {
+		return resp, nil
+	}
+
+	for _, itemType := range domain.CampaignItemTypes {
+		var items []*model.CampaignItem
+		err = r.db.WithContext(ctx).
+			Table(itemTables[itemType]).
+			Select("id, title, status, campaign_id").
+			Where("campaign_id IN ? AND deleted_at IS NULL", ids).
+			Order("id").
+			Find(&items).Error
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items {
+			item.ItemType = itemType
+			resp[item.CampaignID] = append(resp[item.CampaignID], item)
+		}
+	}
+
+	return resp, nil
+}
+
+// lock reads a live campaign for update.
+func lock(tx *gorm.DB, id int64, campaign *model.DiscoverCampaigns) error {
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(campaign).Error
+	if err != nil {
+		return notFound(err)
+	}
+	return nil
+}
+
+// release takes the given items, or all of them when refs is nil, out of campaign.
+// They keep the campaign's window as their own so that leaving does not publish them early.
+func release(tx *gorm.DB, campaign *model.DiscoverCampaigns, refs []domain.DiscoverCampaignItemRef, operatedBy string) error {
+	ids := make(map[string][]int64, len(itemTables))
+	for _, ref := range refs {
+		ids[ref.ItemType] = append(ids[ref.ItemType], ref.ItemID)
+	}
+
+	for _, itemType := range domain.CampaignItemTypes {
+		query := tx.Table(itemTables[itemType]).Where("campaign_id = ?", campaign.ID)
+		if refs != nil {
+			if len(ids[itemType]) == 0 {
+				continue
+			}
+			query = query.Where("id IN ?", ids[itemType])
+		}
+
+		err := query.Updates(map[string]interface{}{
+			"campaign_id": nil,
+			"publish_at":  campaign.PublishAt,
+			"expire_at":   campaign.ExpireAt,
+			"updated_by":  operatedBy,
+			"updated_at":  time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return eerrs.ErrItemNotFound.WrapMsg("campaign not found")
+	}
+	return err
+}
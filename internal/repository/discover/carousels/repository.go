@@ -5,15 +5,13 @@ import (
 
 	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
 )
 
 type Repository interface {
-	Create(ctx context.Context, item *model.DiscoverCarousels) error
-	Find(
-		ctx context.Context, req *domain.DiscoverCarouselsFindReq,
-	) (resp []*model.DiscoverCarousels, count int64, err error)
-	Delete(ctx context.Context, id int64, deletedBy string) error
-	Edit(
-		ctx context.Context, carousel *model.DiscoverCarousels,
-	) (resp *model.DiscoverCarousels, err error)
+	section.Repository[*model.DiscoverCarousels]
+	// Promote makes a variant of the running experiment the slide's own and ends the
+	// experiment, as an edit. It is refused once the experiment is no longer the one
+	// the caller saw.
+	Promote(ctx context.Context, req *domain.DiscoverCarouselPromoteReq) (resp *model.DiscoverCarousels, err error)
 }
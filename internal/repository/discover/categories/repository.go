@@ -0,0 +1,19 @@
+package categories
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	Create(ctx context.Context, item *model.DiscoverCategories) (err error)
+	// Find lists categories by position. With liveOnly set, ArticleCount only counts
+	// published articles inside their publish window.
+	Find(ctx context.Context, liveOnly bool) (resp []*model.DiscoverCategories, err error)
+	Get(ctx context.Context, id int64) (resp *model.DiscoverCategories, err error)
+	Edit(
+		ctx context.Context, category *model.DiscoverCategories,
+	) (resp *model.DiscoverCategories, err error)
+	Delete(ctx context.Context, id int64, deletedBy string) (err error)
+}
@@ -0,0 +1,269 @@
+package categories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/scope"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Create(ctx context.Context, category *model.DiscoverCategories) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("slug", category.Slug),
+		attribute.String("createdBy", category.CreatedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = checkSlug(tx, category.Slug, 0)
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(category).Error
+	})
+	return err
+}
+
+func (r *repositoryImpl) Find(ctx context.Context, liveOnly bool) (resp []*model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Bool("liveOnly", liveOnly))
+
+	articles := r.db.Model(&model.DiscoverArticles{}).
+		Select("category_id, COUNT(*) AS article_count").
+		Where("deleted_at IS NULL").
+		Group("category_id")
+	if liveOnly {
+		articles = articles.
+			Where("status = ?", domain.StatusPublished).
+			Scopes(scope.Schedule(domain.ScheduleLive, time.Now()))
+	}
+
+	var items []*model.DiscoverCategories
+	err = r.db.WithContext(ctx).
+		Table("categories").
+		Select("categories.*, COALESCE(counts.article_count, 0) AS article_count").
+		Joins("LEFT JOIN (?) AS counts ON counts.category_id = categories.id", articles).
+		Where("categories.deleted_at IS NULL").
+		Order("categories.position IS NULL, categories.position, categories.id").
+		Find(&items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func (r *repositoryImpl) Get(ctx context.Context, id int64) (resp *model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("id", id))
+
+	var item model.DiscoverCategories
+	err = r.db.WithContext(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		First(&item).Error
+	if err != nil {
+		return nil, notFound(err)
+	}
+
+	return &item, nil
+}
+
+func (r *repositoryImpl) Edit(
+	ctx context.Context, category *model.DiscoverCategories,
+) (resp *model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", category.ID),
+		attribute.String("slug", category.Slug),
+		attribute.String("updatedBy", category.UpdatedBy),
+	)
+
+	var item model.DiscoverCategories
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", category.ID).
+			First(&item).Error
+		if err != nil {
+			return notFound(err)
+		}
+
+		updates := map[string]interface{}{}
+
+		if category.Slug != "" {
+			err = checkSlug(tx, category.Slug, item.ID)
+			if err != nil {
+				return err
+			}
+			updates["slug"] = category.Slug
+		}
+		if category.Name != "" {
+			updates["name"] = category.Name
+		}
+		if category.IconURL != "" {
+			updates["icon_url"] = category.IconURL
+		}
+		if category.Locales != nil {
+			updates["locales"] = category.Locales
+		}
+		if category.Position != nil {
+			updates["position"] = category.Position
+		}
+		if category.UpdatedBy != "" {
+			updates["updated_by"] = category.UpdatedBy
+		}
+
+		return tx.Model(&item).Updates(updates).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
+
+func (r *repositoryImpl) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("id", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var item model.DiscoverCategories
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			First(&item).Error
+		if err != nil {
+			return notFound(err)
+		}
+
+		var used int64
+		err = tx.Model(&model.DiscoverArticles{}).
+			Where("category_id = ? AND deleted_at IS NULL", id).
+			Count(&used).Error
+		if err != nil {
+			return err
+		}
+		if used > 0 {
+			return eerrs.ErrCategoryInUse.WrapMsg("move or delete the articles of this category first")
+		}
+
+		// Articles waiting in the trash lose the category so a restore cannot point at it.
+		err = tx.Model(&model.DiscoverArticles{}).
+			Where("category_id = ? AND deleted_at IS NOT NULL", id).
+			Update("category_id", nil).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Model(&item).Updates(map[string]interface{}{
+			"deleted_at": time.Now(),
+			"deleted_by": deletedBy,
+		}).Error
+	})
+	return err
+}
+
+// Exists reports an error unless id names a live category; articles use it
+// inside their own transactions.
+func Exists(tx *gorm.DB, id int64) error {
+	var count int64
+	err := tx.Model(&model.DiscoverCategories{}).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count == 0 {
+		return eerrs.ErrItemNotFound.WrapMsg("category not found")
+	}
+	return nil
+}
+
+func notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return eerrs.ErrItemNotFound.WrapMsg("category not found")
+	}
+	return err
+}
+
+// checkSlug fails when another live category already uses slug.
+func checkSlug(tx *gorm.DB, slug string, excludeID int64) error {
+	var count int64
+	err := tx.Model(&model.DiscoverCategories{}).
+		Where("slug = ? AND id <> ? AND deleted_at IS NULL", slug, excludeID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return errs.ErrArgs.WrapMsg("slug already exists")
+	}
+	return nil
+}
@@ -0,0 +1,11 @@
+package dismissals
+
+import (
+	"context"
+)
+
+// Repository keeps, per user, the items of a section the user dismissed.
+type Repository interface {
+	Dismiss(ctx context.Context, itemType, userID string, id int64) (err error)
+	Find(ctx context.Context, itemType, userID string) (ids []int64, err error)
+}
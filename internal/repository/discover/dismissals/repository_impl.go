@@ -0,0 +1,94 @@
+package dismissals
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyDiscoverDismissed = "DISCOVER_DISMISSED:"
+
+	// dismissedTTL is refreshed on every dismissal, so the set of an inactive user
+	// eventually expires while an active user keeps all of theirs.
+	dismissedTTL = 90 * 24 * time.Hour
+)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Dismiss(ctx context.Context, itemType, userID string, id int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.String("userID", userID),
+		attribute.Int64("id", id),
+	)
+
+	key := dismissedKey(itemType, userID)
+	_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.SAdd(ctx, key, id)
+		pipe.Expire(ctx, key, dismissedTTL)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Find(ctx context.Context, itemType, userID string) (ids []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.String("userID", userID),
+	)
+
+	members, err := r.rdb.SMembers(ctx, dismissedKey(itemType, userID)).Result()
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	ids = make([]int64, 0, len(members))
+	for _, member := range members {
+		id, parseErr := strconv.ParseInt(member, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func dismissedKey(itemType, userID string) string {
+	return CacheKeyDiscoverDismissed + itemType + ":" + userID
+}
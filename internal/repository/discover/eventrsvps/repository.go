@@ -0,0 +1,21 @@
+package eventrsvps
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	// Join records the RSVP of the user and takes a seat of the event, failing with
+	// ErrEventFull when none is left and ErrAlreadyJoined on a second RSVP.
+	Join(ctx context.Context, eventID int64, userID string) (err error)
+	// Cancel withdraws the RSVP of the user and frees the seat.
+	Cancel(ctx context.Context, eventID int64, userID string) (err error)
+	// FindEvents lists the events the user joined, latest start first.
+	FindEvents(
+		ctx context.Context, userID string, page, limit int32,
+	) (resp []*model.DiscoverEvents, count int64, err error)
+	// Joined returns which of eventIDs the user joined.
+	Joined(ctx context.Context, userID string, eventIDs []int64) (resp []int64, err error)
+}
@@ -0,0 +1,179 @@
+package eventrsvps
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Join(ctx context.Context, eventID int64, userID string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("eventID", eventID),
+		attribute.String("userID", userID),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.DiscoverEventRSVPs{EventID: eventID, UserID: userID})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return eerrs.ErrAlreadyJoined.WrapMsg("user already joined this event")
+		}
+
+		// The seat is taken with a conditional increment, so concurrent RSVPs can
+		// never push the count past the capacity.
+		result = tx.Model(&model.DiscoverEvents{}).
+			Where("id = ? AND (capacity IS NULL OR rsvp_count < capacity)", eventID).
+			Update("rsvp_count", gorm.Expr("rsvp_count + 1"))
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return eerrs.ErrEventFull.WrapMsg("event is full")
+		}
+
+		return nil
+	})
+	return err
+}
+
+func (r *repositoryImpl) Cancel(ctx context.Context, eventID int64, userID string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("eventID", eventID),
+		attribute.String("userID", userID),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		result := tx.Where("event_id = ? AND user_id = ?", eventID, userID).
+			Delete(&model.DiscoverEventRSVPs{})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return eerrs.ErrItemNotFound.WrapMsg("rsvp not found")
+		}
+
+		return tx.Model(&model.DiscoverEvents{}).
+			Where("id = ? AND rsvp_count > 0", eventID).
+			Update("rsvp_count", gorm.Expr("rsvp_count - 1")).Error
+	})
+	return err
+}
+
+func (r *repositoryImpl) FindEvents(
+	ctx context.Context, userID string, page, limit int32,
+) (resp []*model.DiscoverEvents, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", userID),
+		attribute.Int("page", int(page)),
+		attribute.Int("limit", int(limit)),
+	)
+
+	query := r.db.WithContext(ctx).
+		Model(&model.DiscoverEvents{}).
+		Joins("JOIN event_rsvps ON event_rsvps.event_id = events.id").
+		Where("event_rsvps.user_id = ? AND events.deleted_at IS NULL", userID)
+
+	err = query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if count == 0 {
+		return []*model.DiscoverEvents{}, 0, nil
+	}
+
+	err = query.Select("events.*").
+		Order("events.starts_at DESC").
+		Limit(int(limit)).
+		Offset(int((page - 1) * limit)).
+		Find(&resp).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp, count, nil
+}
+
+func (r *repositoryImpl) Joined(
+	ctx context.Context, userID string, eventIDs []int64,
+) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", userID),
+		attribute.Int64Slice("eventIDs", eventIDs),
+	)
+
+	resp = []int64{}
+	if len(eventIDs) == 0 {
+		return resp, nil
+	}
+
+	err = r.db.WithContext(ctx).
+		Model(&model.DiscoverEventRSVPs{}).
+		Where("user_id = ? AND event_id IN ?", userID, eventIDs).
+		Pluck("event_id", &resp).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
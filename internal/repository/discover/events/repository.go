@@ -0,0 +1,52 @@
+package events
+
+import (
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/scope"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+type Repository = section.Repository[*model.DiscoverEvents]
+
+func New(db *gorm.DB) Repository {
+	return section.New[model.DiscoverEvents](db, section.Options[*model.DiscoverEvents]{
+		ItemType: domain.ItemTypeEvent,
+		Columns:  []string{"location", "starts_at", "ends_at", "capacity"},
+		Filters: []section.Filter{
+			{Param: "timeframe", Scope: timeframe},
+		},
+		Validate: validate,
+		Purge:    purge,
+	})
+}
+
+// validate checks the event time once edits are merged into the stored one.
+func validate(_ *gorm.DB, event *model.DiscoverEvents) error {
+	if !event.EndsAt.After(event.StartsAt) {
+		return errs.ErrArgs.WrapMsg("endsAt must be after startsAt")
+	}
+	return nil
+}
+
+// purge removes the RSVPs of purged events.
+func purge(tx *gorm.DB, ids []int64) error {
+	return tx.Where("event_id IN ?", ids).Delete(&model.DiscoverEventRSVPs{}).Error
+}
+
+func timeframe(value string) (func(db *gorm.DB) *gorm.DB, error) {
+	value = strings.ToLower(value)
+	switch value {
+	case domain.TimeframeAll, domain.TimeframeCurrent, domain.TimeframeUpcoming,
+		domain.TimeframeOngoing, domain.TimeframePast:
+		return scope.Timeframe(value, time.Now()), nil
+	default:
+		return nil, errs.ErrArgs.WrapMsg("invalid timeframe query param: must be all, current, upcoming, ongoing or past")
+	}
+}
@@ -0,0 +1,39 @@
+package featuredgroups
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+type Repository = section.Repository[*model.DiscoverFeaturedGroups]
+
+func New(db *gorm.DB) Repository {
+	return section.New[model.DiscoverFeaturedGroups](db, section.Options[*model.DiscoverFeaturedGroups]{
+		ItemType: domain.ItemTypeGroup,
+		Columns:  []string{"group_id"},
+		Filters: []section.Filter{
+			{Param: "groupId", Where: "group_id = ?"},
+		},
+		Validate: validate,
+	})
+}
+
+// validate checks that a group is pinned by one live item only.
+func validate(tx *gorm.DB, item *model.DiscoverFeaturedGroups) error {
+	var count int64
+	err := tx.Model(&model.DiscoverFeaturedGroups{}).
+		Where("group_id = ? AND id <> ? AND deleted_at IS NULL", item.GroupID, item.ID).
+		Count(&count).Error
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		return errs.ErrArgs.WrapMsg("group " + item.GroupID + " is already featured")
+	}
+	return nil
+}
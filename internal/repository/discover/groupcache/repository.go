@@ -0,0 +1,15 @@
+package groupcache
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+// Repository caches the IM groups shown on the discover page. A group cached as nil
+// must not be shown: it is gone, dismissed or private.
+type Repository interface {
+	// Find returns the cached groups among groupIDs, keyed by group ID.
+	Find(ctx context.Context, groupIDs []string) (resp map[string]*model.IMGroup, err error)
+	Save(ctx context.Context, groups map[string]*model.IMGroup) (err error)
+}
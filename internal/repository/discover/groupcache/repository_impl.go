@@ -0,0 +1,114 @@
+package groupcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyDiscoverIMGroup = "DISCOVER_IM_GROUP:"
+
+	// groupTTL bounds how stale the name, avatar and member count of a group may get.
+	groupTTL = 5 * time.Minute
+
+	// hiddenGroup is cached for the groups that must not be shown.
+	hiddenGroup = "null"
+)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Find(
+	ctx context.Context, groupIDs []string,
+) (resp map[string]*model.IMGroup, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.StringSlice("groupIDs", groupIDs))
+
+	resp = make(map[string]*model.IMGroup, len(groupIDs))
+	if len(groupIDs) == 0 {
+		return resp, nil
+	}
+
+	// Keys are read one by one in a pipeline, MGET would fail across cluster slots.
+	cmds := make([]*redis.StringCmd, len(groupIDs))
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, groupID := range groupIDs {
+			cmds[i] = pipe.Get(ctx, CacheKeyDiscoverIMGroup+groupID)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	for i, cmd := range cmds {
+		value, cmdErr := cmd.Result()
+		if cmdErr != nil {
+			continue
+		}
+
+		var group *model.IMGroup
+		if jsonErr := json.Unmarshal([]byte(value), &group); jsonErr != nil {
+			continue
+		}
+		resp[groupIDs[i]] = group
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) Save(ctx context.Context, groups map[string]*model.IMGroup) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("groups", len(groups)))
+
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for groupID, group := range groups {
+			value := hiddenGroup
+			if group != nil {
+				raw, jsonErr := json.Marshal(group)
+				if jsonErr != nil {
+					return jsonErr
+				}
+				value = string(raw)
+			}
+			pipe.Set(ctx, CacheKeyDiscoverIMGroup+groupID, value, groupTTL)
+		}
+		return nil
+	})
+	return errs.Wrap(err)
+}
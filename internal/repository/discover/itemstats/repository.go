@@ -0,0 +1,27 @@
+package itemstats
+
+import (
+	"context"
+	"time"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	// Rollup recomputes the statistics of every item with tracking events on the given UTC day.
+	Rollup(ctx context.Context, day time.Time) (err error)
+	// SaveViewers stores the approximate viewers of items on a UTC day, never lowering a
+	// count already stored, so that counters lost by Redis do not erase the persisted ones.
+	SaveViewers(ctx context.Context, day time.Time, rows []*model.DiscoverItemStatsDaily) (err error)
+	// Find lists the daily statistics, latest day first.
+	Find(
+		ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+	) (resp []*model.DiscoverItemStatsDaily, count int64, err error)
+	// Top returns the req.Top best items over the range by req.By, except the viewers,
+	// with their Totals; the day and the viewers of the returned rows are left empty.
+	Top(ctx context.Context, req *domain.DiscoverItemStatsFindReq) (resp []*model.DiscoverItemStatsDaily, err error)
+	// Totals sets the impressions and clicks of the items of rows summed over the range,
+	// and their unique users counted once over the whole range from the tracking events.
+	Totals(ctx context.Context, req *domain.DiscoverItemStatsFindReq, rows []*model.DiscoverItemStatsDaily) (err error)
+}
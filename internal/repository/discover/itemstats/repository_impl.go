@@ -0,0 +1,321 @@
+package itemstats
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// rollupQuery rebuilds the rows of a day from its tracking events; running it again
+// for the same day replaces the counts, so late events are picked up by a later run.
+const rollupQuery = `INSERT INTO item_stats_daily
+	(day, item_type, item_id, impressions, clicks, unique_users, created_at, updated_at)
+SELECT ?, item_type, item_id, SUM(kind = ?), SUM(kind = ?), COUNT(DISTINCT NULLIF(user_id, '')), ?, ?
+FROM tracking_events
+WHERE occurred_at >= ? AND occurred_at < ?
+GROUP BY item_type, item_id
+ON DUPLICATE KEY UPDATE
+	impressions = VALUES(impressions),
+	clicks = VALUES(clicks),
+	unique_users = VALUES(unique_users),
+	updated_at = VALUES(updated_at)`
+
+// saveViewersQuery inserts the rows of a day missing from the rollup and raises the
+// viewers of the others; the placeholders of each row are appended to it.
+const saveViewersQuery = `INSERT INTO item_stats_daily
+	(day, item_type, item_id, viewers, created_at, updated_at)
+VALUES %s
+ON DUPLICATE KEY UPDATE
+	viewers = GREATEST(viewers, VALUES(viewers)),
+	updated_at = VALUES(updated_at)`
+
+// saveViewersBatch bounds the rows of one INSERT statement.
+const saveViewersBatch = 500
+
+// topOrders maps the metrics of the top-N ranking that add up over days to their ORDER BY
+// expression on the daily rows.
+var topOrders = map[string]string{
+	domain.StatsByImpressions: "SUM(impressions) DESC",
+	domain.StatsByClicks:      "SUM(clicks) DESC",
+	domain.StatsByCTR:         "SUM(clicks) / NULLIF(SUM(impressions), 0) DESC",
+}
+
+// uniqueUsersColumn counts the distinct users of the tracking events of an item.
+const uniqueUsersColumn = "COUNT(DISTINCT NULLIF(user_id, '')) AS unique_users"
+
+// itemKey identifies the rows of an item.
+type itemKey struct {
+	itemType string
+	itemID   int64
+}
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Rollup(ctx context.Context, day time.Time) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	start := day.UTC().Truncate(24 * time.Hour)
+	span.SetAttributes(attribute.String("day", start.Format(domain.StatsDayLayout)))
+
+	now := time.Now()
+	return r.db.WithContext(ctx).Exec(rollupQuery,
+		start.Format(domain.StatsDayLayout), domain.TrackingImpression, domain.TrackingClick, now, now,
+		start, start.AddDate(0, 0, 1),
+	).Error
+}
+
+func (r *repositoryImpl) SaveViewers(
+	ctx context.Context, day time.Time, rows []*model.DiscoverItemStatsDaily,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	dayValue := day.UTC().Format(domain.StatsDayLayout)
+	span.SetAttributes(
+		attribute.String("day", dayValue),
+		attribute.Int("rows", len(rows)),
+	)
+
+	now := time.Now()
+	for start := 0; start < len(rows); start += saveViewersBatch {
+		batch := rows[start:min(start+saveViewersBatch, len(rows))]
+
+		placeholders := make([]string, 0, len(batch))
+		var args []any
+		for _, row := range batch {
+			placeholders = append(placeholders, "(?, ?, ?, ?, ?, ?)")
+			args = append(args, dayValue, row.ItemType, row.ItemID, row.Viewers, now, now)
+		}
+
+		query := fmt.Sprintf(saveViewersQuery, strings.Join(placeholders, ", "))
+		err = r.db.WithContext(ctx).Exec(query, args...).Error
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *repositoryImpl) Find(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+) (resp []*model.DiscoverItemStatsDaily, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("from", req.From.Format(domain.StatsDayLayout)),
+		attribute.String("to", req.To.Format(domain.StatsDayLayout)),
+		attribute.String("itemType", req.ItemType),
+		attribute.Int64("itemID", req.ItemID),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+	)
+
+	query := r.filter(ctx, req)
+
+	err = query.Count(&count).Error
+	if err != nil {
+		return nil, 0, err
+	}
+	if count == 0 {
+		return []*model.DiscoverItemStatsDaily{}, 0, nil
+	}
+
+	err = query.Order("day DESC, item_type, item_id").
+		Limit(int(req.Limit)).
+		Offset(int((req.Page - 1) * req.Limit)).
+		Find(&resp).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return resp, count, nil
+}
+
+func (r *repositoryImpl) Top(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+) (resp []*model.DiscoverItemStatsDaily, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("from", req.From.Format(domain.StatsDayLayout)),
+		attribute.String("to", req.To.Format(domain.StatsDayLayout)),
+		attribute.String("itemType", req.ItemType),
+		attribute.Int("top", req.Top),
+		attribute.String("by", req.By),
+	)
+
+	// Distinct users do not add up over days; they are ranked from the events themselves.
+	if req.By == domain.StatsByUniqueUsers {
+		err = r.events(ctx, req).
+			Select("item_type, item_id, " + uniqueUsersColumn).
+			Group("item_type, item_id").
+			Order("unique_users DESC, item_type, item_id").
+			Limit(req.Top).
+			Scan(&resp).Error
+	} else {
+		order, ok := topOrders[req.By]
+		if !ok {
+			order = topOrders[domain.StatsByImpressions]
+		}
+
+		err = r.filter(ctx, req).
+			Select("item_type, item_id").
+			Group("item_type, item_id").
+			Order(order + ", item_type, item_id").
+			Limit(req.Top).
+			Scan(&resp).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.Totals(ctx, req, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (r *repositoryImpl) Totals(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq, rows []*model.DiscoverItemStatsDaily,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("from", req.From.Format(domain.StatsDayLayout)),
+		attribute.String("to", req.To.Format(domain.StatsDayLayout)),
+		attribute.Int("rows", len(rows)),
+	)
+
+	if len(rows) == 0 {
+		return nil
+	}
+
+	items := make([][]interface{}, 0, len(rows))
+	byItem := make(map[itemKey]*model.DiscoverItemStatsDaily, len(rows))
+	for _, row := range rows {
+		items = append(items, []interface{}{row.ItemType, row.ItemID})
+		byItem[itemKey{row.ItemType, row.ItemID}] = row
+	}
+
+	var sums []*model.DiscoverItemStatsDaily
+	err = r.filter(ctx, req).
+		Select("item_type, item_id, SUM(impressions) AS impressions, SUM(clicks) AS clicks").
+		Where("(item_type, item_id) IN ?", items).
+		Group("item_type, item_id").
+		Scan(&sums).Error
+	if err != nil {
+		return err
+	}
+	for _, sum := range sums {
+		if row, ok := byItem[itemKey{sum.ItemType, sum.ItemID}]; ok {
+			row.Impressions, row.Clicks = sum.Impressions, sum.Clicks
+		}
+	}
+
+	var users []*model.DiscoverItemStatsDaily
+	err = r.events(ctx, req).
+		Select("item_type, item_id, "+uniqueUsersColumn).
+		Where("(item_type, item_id) IN ?", items).
+		Group("item_type, item_id").
+		Scan(&users).Error
+	if err != nil {
+		return err
+	}
+	for _, user := range users {
+		if row, ok := byItem[itemKey{user.ItemType, user.ItemID}]; ok {
+			row.UniqueUsers = user.UniqueUsers
+		}
+	}
+
+	return nil
+}
+
+// filter selects the rows of the requested days, item type and item.
+func (r *repositoryImpl) filter(ctx context.Context, req *domain.DiscoverItemStatsFindReq) *gorm.DB {
+	query := r.db.WithContext(ctx).
+		Model(&model.DiscoverItemStatsDaily{}).
+		Where("day BETWEEN ? AND ?", req.From.Format(domain.StatsDayLayout), req.To.Format(domain.StatsDayLayout))
+	if req.ItemType != "" {
+		query = query.Where("item_type = ?", req.ItemType)
+	}
+	if req.ItemID != 0 {
+		query = query.Where("item_id = ?", req.ItemID)
+	}
+
+	return query
+}
+
+// events selects the tracking events of the requested days, item type and item.
+func (r *repositoryImpl) events(ctx context.Context, req *domain.DiscoverItemStatsFindReq) *gorm.DB {
+	from := req.From.UTC().Truncate(24 * time.Hour)
+	to := req.To.UTC().Truncate(24*time.Hour).AddDate(0, 0, 1)
+
+	query := r.db.WithContext(ctx).
+		Model(&model.DiscoverTrackingEvents{}).
+		Where("occurred_at >= ? AND occurred_at < ?", from, to)
+	if req.ItemType != "" {
+		query = query.Where("item_type = ?", req.ItemType)
+	}
+	if req.ItemID != 0 {
+		query = query.Where("item_id = ?", req.ItemID)
+	}
+
+	return query
+}
@@ -0,0 +1,14 @@
+package pagelayout
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	// Find lists the sections of the discover page by position.
+	Find(ctx context.Context) (resp []*model.DiscoverPageSections, err error)
+	// Replace swaps the whole layout for sections in one transaction.
+	Replace(ctx context.Context, sections []*model.DiscoverPageSections) (err error)
+}
@@ -0,0 +1,70 @@
+package pagelayout
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Find(ctx context.Context) (resp []*model.DiscoverPageSections, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	err = r.db.WithContext(ctx).
+		Order("position ASC").
+		Find(&resp).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) Replace(ctx context.Context, sections []*model.DiscoverPageSections) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("sections", len(sections)))
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Where("1 = 1").Delete(&model.DiscoverPageSections{}).Error
+		if err != nil {
+			return err
+		}
+
+		if len(sections) == 0 {
+			return nil
+		}
+		return tx.Create(&sections).Error
+	})
+	return err
+}
@@ -0,0 +1,26 @@
+package pollcounts
+
+import (
+	"context"
+)
+
+// Repository keeps the live vote counts of the polls. MySQL holds the votes themselves;
+// a missing count is rebuilt from there.
+type Repository interface {
+	// Incr counts a vote for optionIDs. When the counts of the poll are not loaded, it
+	// bumps the version of the poll instead, so that a rebuild racing with the vote is
+	// not stored.
+	Incr(ctx context.Context, pollID int64, optionIDs []int64) (err error)
+	// Version returns the version of the poll, to be read before counting its votes.
+	Version(ctx context.Context, pollID int64) (version int64, err error)
+	// Get returns the counts by option and the number of voters; ok is false when the
+	// counts of the poll are not loaded.
+	Get(ctx context.Context, pollID int64) (counts map[int64]int64, voters int64, ok bool, err error)
+	// Load stores counts rebuilt from the votes, unless counts were loaded meanwhile or a
+	// vote was missed since version was read.
+	Load(ctx context.Context, pollID int64, version int64, counts map[int64]int64, voters int64) (err error)
+	// Drop forgets the counts of the poll so that the next read rebuilds them.
+	Drop(ctx context.Context, pollID int64) (err error)
+	// Forget removes the counts and the version of polls that no longer exist.
+	Forget(ctx context.Context, pollIDs []int64) (err error)
+}
@@ -0,0 +1,233 @@
+package pollcounts
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyDiscoverPollCounts = "DISCOVER_POLL_COUNTS:"
+	// CacheKeyDiscoverPollVersion counts the votes Incr found no counts for.
+	CacheKeyDiscoverPollVersion = "DISCOVER_POLL_VERSION:"
+
+	// votersField holds the number of voters next to the option fields of a count hash.
+	votersField = "voters"
+
+	// countsTTL lets the counts of polls nobody reads anymore expire; they are rebuilt
+	// from MySQL when read again.
+	countsTTL = 7 * 24 * time.Hour
+)
+
+// incrScript counts one vote: the voters field and every option picked, in one step.
+// Without counts, it bumps the version so that a rebuild that missed the vote is refused.
+var incrScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 0 then
+	redis.call("INCR", KEYS[2])
+	redis.call("EXPIRE", KEYS[2], ARGV[1])
+	return 0
+end
+redis.call("HINCRBY", KEYS[1], ARGV[2], 1)
+for i = 3, #ARGV do
+	redis.call("HINCRBY", KEYS[1], ARGV[i], 1)
+end
+return 1
+`)
+
+// loadScript stores rebuilt counts unless another rebuild got there first or a vote
+// arrived since the version the rebuild started from.
+var loadScript = redis.NewScript(`
+if redis.call("EXISTS", KEYS[1]) == 1 then
+	return 0
+end
+if (redis.call("GET", KEYS[2]) or "0") ~= ARGV[2] then
+	return 0
+end
+redis.call("HSET", KEYS[1], unpack(ARGV, 3))
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+return 1
+`)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Incr(ctx context.Context, pollID int64, optionIDs []int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", pollID),
+		attribute.Int64Slice("optionIDs", optionIDs),
+	)
+
+	args := make([]interface{}, 0, len(optionIDs)+2)
+	args = append(args, int64(countsTTL/time.Second), votersField)
+	for _, optionID := range optionIDs {
+		args = append(args, optionID)
+	}
+
+	err = incrScript.Run(ctx, r.rdb, []string{countsKey(pollID), versionKey(pollID)}, args...).Err()
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Version(ctx context.Context, pollID int64) (version int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("pollID", pollID))
+
+	version, err = r.rdb.Get(ctx, versionKey(pollID)).Int64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return version, errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Get(
+	ctx context.Context, pollID int64,
+) (counts map[int64]int64, voters int64, ok bool, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("pollID", pollID))
+
+	fields, err := r.rdb.HGetAll(ctx, countsKey(pollID)).Result()
+	if err != nil {
+		return nil, 0, false, errs.Wrap(err)
+	}
+	if len(fields) == 0 {
+		return nil, 0, false, nil
+	}
+
+	counts = make(map[int64]int64, len(fields))
+	for field, value := range fields {
+		count, parseErr := strconv.ParseInt(value, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		if field == votersField {
+			voters = count
+			continue
+		}
+		optionID, parseErr := strconv.ParseInt(field, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		counts[optionID] = count
+	}
+
+	return counts, voters, true, nil
+}
+
+func (r *repositoryImpl) Load(
+	ctx context.Context, pollID int64, version int64, counts map[int64]int64, voters int64,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", pollID),
+		attribute.Int64("version", version),
+		attribute.Int64("voters", voters),
+	)
+
+	args := make([]interface{}, 0, 2*len(counts)+4)
+	args = append(args, int64(countsTTL/time.Second), strconv.FormatInt(version, 10), votersField, voters)
+	for optionID, count := range counts {
+		args = append(args, optionID, count)
+	}
+
+	err = loadScript.Run(ctx, r.rdb, []string{countsKey(pollID), versionKey(pollID)}, args...).Err()
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Drop(ctx context.Context, pollID int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("pollID", pollID))
+
+	return errs.Wrap(r.rdb.Del(ctx, countsKey(pollID)).Err())
+}
+
+func (r *repositoryImpl) Forget(ctx context.Context, pollIDs []int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("count", len(pollIDs)))
+
+	// One DEL per poll, since the keys of different polls may live on different nodes.
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, pollID := range pollIDs {
+			pipe.Del(ctx, countsKey(pollID), versionKey(pollID))
+		}
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+// The keys of a poll share a hash tag, so that the scripts reach both on a cluster.
+func countsKey(pollID int64) string {
+	return CacheKeyDiscoverPollCounts + "{" + strconv.FormatInt(pollID, 10) + "}"
+}
+
+func versionKey(pollID int64) string {
+	return CacheKeyDiscoverPollVersion + "{" + strconv.FormatInt(pollID, 10) + "}"
+}
@@ -0,0 +1,42 @@
+package polls
+
+import (
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pollcounts"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+type Repository = section.Repository[*model.DiscoverPolls]
+
+func New(db *gorm.DB, counts pollcounts.Repository) Repository {
+	return section.New[model.DiscoverPolls](db, section.Options[*model.DiscoverPolls]{
+		ItemType: domain.ItemTypePoll,
+		// Options and the choice mode never change, see domain.DiscoverPollsAddReq.
+		Columns:  []string{"opens_at", "closes_at"},
+		Validate: validate,
+		Purge:    purge(counts),
+	})
+}
+
+// validate checks the voting window once edits are merged into the stored one.
+func validate(_ *gorm.DB, poll *model.DiscoverPolls) error {
+	if !domain.ValidSchedule(poll.OpensAt, poll.ClosesAt) {
+		return errs.ErrArgs.WrapMsg("closesAt must be after opensAt")
+	}
+	return nil
+}
+
+// purge removes the votes of purged polls along with their counts.
+func purge(counts pollcounts.Repository) func(tx *gorm.DB, ids []int64) error {
+	return func(tx *gorm.DB, ids []int64) error {
+		err := tx.Where("poll_id IN ?", ids).Delete(&model.DiscoverPollVotes{}).Error
+		if err != nil {
+			return err
+		}
+		return counts.Forget(tx.Statement.Context, ids)
+	}
+}
@@ -0,0 +1,16 @@
+package pollvotes
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	// Create stores a vote; it fails with ErrAlreadyVoted when the user already voted on the poll.
+	Create(ctx context.Context, vote *model.DiscoverPollVotes) (err error)
+	// Get returns the vote of the user on the poll, or nil when the user has not voted.
+	Get(ctx context.Context, pollID int64, userID string) (resp *model.DiscoverPollVotes, err error)
+	// Count tallies the stored votes of a poll by option.
+	Count(ctx context.Context, pollID int64) (counts map[int64]int64, voters int64, err error)
+}
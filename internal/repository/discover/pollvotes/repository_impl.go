@@ -0,0 +1,126 @@
+package pollvotes
+
+import (
+	"context"
+	"errors"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// countBatchSize bounds how many votes are loaded at once when tallying a poll.
+const countBatchSize = 1000
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Create(ctx context.Context, vote *model.DiscoverPollVotes) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", vote.PollID),
+		attribute.String("userID", vote.UserID),
+	)
+
+	// The unique index on poll and user is what enforces one vote per user.
+	result := r.db.WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(vote)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return eerrs.ErrAlreadyVoted.WrapMsg("user already voted on this poll")
+	}
+
+	return nil
+}
+
+func (r *repositoryImpl) Get(
+	ctx context.Context, pollID int64, userID string,
+) (resp *model.DiscoverPollVotes, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", pollID),
+		attribute.String("userID", userID),
+	)
+
+	resp = &model.DiscoverPollVotes{}
+	err = r.db.WithContext(ctx).
+		Where("poll_id = ? AND user_id = ?", pollID, userID).
+		First(resp).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) Count(
+	ctx context.Context, pollID int64,
+) (counts map[int64]int64, voters int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("pollID", pollID))
+
+	counts = map[int64]int64{}
+	var votes []*model.DiscoverPollVotes
+	err = r.db.WithContext(ctx).
+		Select("id", "option_ids").
+		Where("poll_id = ?", pollID).
+		FindInBatches(&votes, countBatchSize, func(_ *gorm.DB, _ int) error {
+			for _, vote := range votes {
+				voters++
+				for _, optionID := range vote.OptionIDs {
+					counts[optionID]++
+				}
+			}
+			return nil
+		}).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	return counts, voters, nil
+}
@@ -0,0 +1,27 @@
+package quicklinks
+
+import (
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+)
+
+type Repository = section.Repository[*model.DiscoverQuickLinks]
+
+func New(db *gorm.DB) Repository {
+	return section.New[model.DiscoverQuickLinks](db, section.Options[*model.DiscoverQuickLinks]{
+		ItemType: domain.ItemTypeQuickLink,
+		Columns:  []string{"group_name"},
+		Filters: []section.Filter{
+			{Param: "group", Where: "group_name = ?", Normalize: normalizeGroup},
+		},
+	})
+}
+
+func normalizeGroup(value string) (interface{}, error) {
+	return strings.TrimSpace(value), nil
+}
@@ -0,0 +1,13 @@
+package relatedcache
+
+import (
+	"context"
+)
+
+// Repository caches the neighbor list of each article. The lists may name articles
+// that stopped being visible since, readers resolve them again.
+type Repository interface {
+	// Find returns the cached neighbors of an article; ok is false on a miss.
+	Find(ctx context.Context, articleID int64) (resp []int64, ok bool, err error)
+	Save(ctx context.Context, articleID int64, related []int64) (err error)
+}
@@ -0,0 +1,94 @@
+package relatedcache
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyRelatedArticles = "DISCOVER_RELATED_ARTICLES:"
+
+	// relatedTTL bounds how long edits to tags, categories and titles take to show
+	// in the suggestions.
+	relatedTTL = 30 * time.Minute
+)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Find(ctx context.Context, articleID int64) (resp []int64, ok bool, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("articleID", articleID))
+
+	value, err := r.rdb.Get(ctx, key(articleID)).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, errs.Wrap(err)
+	}
+
+	err = json.Unmarshal([]byte(value), &resp)
+	if err != nil {
+		return nil, false, errs.Wrap(err)
+	}
+
+	return resp, true, nil
+}
+
+func (r *repositoryImpl) Save(ctx context.Context, articleID int64, related []int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("articleID", articleID),
+		attribute.Int("related", len(related)),
+	)
+
+	if related == nil {
+		related = []int64{}
+	}
+	value, err := json.Marshal(related)
+	if err != nil {
+		return errs.Wrap(err)
+	}
+
+	return errs.Wrap(r.rdb.Set(ctx, key(articleID), value, relatedTTL).Err())
+}
+
+func key(articleID int64) string {
+	return CacheKeyRelatedArticles + strconv.FormatInt(articleID, 10)
+}
@@ -0,0 +1,95 @@
+package revisions
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Find(
+	ctx context.Context, itemType string, itemID int64,
+) (resp []*model.DiscoverRevisions, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.Int64("itemID", itemID),
+	)
+
+	err = r.db.WithContext(ctx).
+		Where("item_type = ? AND item_id = ?", itemType, itemID).
+		Order("revision DESC").
+		Find(&resp).Error
+
+	return resp, err
+}
+
+// Record stores snapshot as the next revision of the item. It is meant to be
+// called inside the transaction that changed the item, after the change.
+func Record(tx *gorm.DB, itemType string, itemID int64, action, actor, note string, snapshot any) error {
+	data, err := json.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+
+	var last int
+	err = tx.Model(&model.DiscoverRevisions{}).
+		Where("item_type = ? AND item_id = ?", itemType, itemID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&last).Error
+	if err != nil {
+		return err
+	}
+
+	return tx.Create(&model.DiscoverRevisions{
+		ItemType:  itemType,
+		ItemID:    itemID,
+		Revision:  last + 1,
+		Action:    action,
+		Snapshot:  data,
+		Note:      note,
+		CreatedBy: actor,
+	}).Error
+}
+
+// Get loads a single revision of an item.
+func Get(tx *gorm.DB, itemType string, itemID int64, revision int) (resp *model.DiscoverRevisions, err error) {
+	var item model.DiscoverRevisions
+	err = tx.Where("item_type = ? AND item_id = ? AND revision = ?", itemType, itemID, revision).
+		First(&item).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, eerrs.ErrItemNotFound.WrapMsg(fmt.Sprintf("revision %d not found", revision))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &item, nil
+}
@@ -0,0 +1,26 @@
+package scope
+
+import (
+	"strconv"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+)
+
+// Audience restricts a query on a discover table to the rows whose platform, region and
+// user type rules admit audience. The app version rules cannot be compared in SQL and
+// are left to the caller. Rules are stored normalized and empty ones are omitted from
+// the JSON, see model.Targeting.
+func Audience(audience *domain.Audience) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.
+			Where("JSON_EXTRACT(targeting, '$.platforms') IS NULL OR "+
+				"JSON_CONTAINS(targeting, JSON_QUOTE(?), '$.platforms')", strings.ToLower(audience.Platform)).
+			Where("JSON_EXTRACT(targeting, '$.regions') IS NULL OR "+
+				"JSON_CONTAINS(targeting, JSON_QUOTE(?), '$.regions')", strings.ToUpper(audience.Region)).
+			Where("JSON_EXTRACT(targeting, '$.userTypes') IS NULL OR "+
+				"JSON_CONTAINS(targeting, ?, '$.userTypes')", strconv.FormatInt(int64(audience.UserType), 10))
+	}
+}
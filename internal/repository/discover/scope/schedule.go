@@ -0,0 +1,44 @@
+package scope
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+// Schedule restricts a query on a discover table to the rows matching the
+// given schedule filter, evaluated at now. Items of a campaign also follow the
+// campaign's window, and are not live while it is paused.
+func Schedule(schedule string, now time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch schedule {
+		case domain.ScheduleLive:
+			return db.
+				Where("publish_at IS NULL OR publish_at <= ?", now).
+				Where("expire_at IS NULL OR expire_at > ?", now).
+				Where("campaign_id IS NULL OR campaign_id IN (?)", campaigns(db).
+					Where("paused = ?", false).
+					Where("publish_at IS NULL OR publish_at <= ?", now).
+					Where("expire_at IS NULL OR expire_at > ?", now))
+		case domain.ScheduleUpcoming:
+			return db.Where("publish_at > ? OR campaign_id IN (?)", now,
+				campaigns(db).Where("publish_at > ?", now))
+		case domain.ScheduleExpired:
+			return db.Where("expire_at <= ? OR campaign_id IN (?)", now,
+				campaigns(db).Where("expire_at <= ?", now))
+		default:
+			return db
+		}
+	}
+}
+
+// campaigns starts a subquery selecting the ids of the campaigns not deleted.
+func campaigns(db *gorm.DB) *gorm.DB {
+	return db.Session(&gorm.Session{NewDB: true}).
+		Model(&model.DiscoverCampaigns{}).
+		Select("id").
+		Where("deleted_at IS NULL")
+}
@@ -0,0 +1,28 @@
+package scope
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+)
+
+// Timeframe restricts a query on the events table to the events matching the given
+// timeframe filter, evaluated at now.
+func Timeframe(timeframe string, now time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		switch timeframe {
+		case domain.TimeframeCurrent:
+			return db.Where("ends_at > ?", now)
+		case domain.TimeframeUpcoming:
+			return db.Where("starts_at > ?", now)
+		case domain.TimeframeOngoing:
+			return db.Where("starts_at <= ? AND ends_at > ?", now, now)
+		case domain.TimeframePast:
+			return db.Where("ends_at <= ?", now)
+		default:
+			return db
+		}
+	}
+}
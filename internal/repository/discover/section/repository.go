@@ -0,0 +1,62 @@
+package section
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+)
+
+// Filter is a find filter specific to one section. When the Param query value is set,
+// it is normalized and applied as the Where condition, or handed to Scope when the
+// filter needs more than one condition.
+type Filter struct {
+	Param     string
+	Where     string
+	Normalize func(value string) (interface{}, error)
+	Scope     func(value string) (func(db *gorm.DB) *gorm.DB, error)
+}
+
+// Options registers a section type with the generic repository.
+type Options[P any] struct {
+	// ItemType names the section in revisions, status logs and error messages.
+	ItemType string
+	// UniqueTitle rejects a title already used by another live item of the section.
+	UniqueTitle bool
+	// Columns lists the section's own columns, restored with the shared ones on rollback.
+	Columns []string
+	// ListOmit lists heavy columns left out of Find; Get still returns them.
+	ListOmit []string
+	Filters  []Filter
+	// Validate checks the section's references inside the write transaction of
+	// create, edit, rollback and restore, once the item holds its new values.
+	Validate func(tx *gorm.DB, item P) error
+	// Purge removes the section's own records of the given items inside the purge
+	// transaction, before the items themselves.
+	Purge func(tx *gorm.DB, ids []int64) error
+}
+
+type Repository[P any] interface {
+	ItemType() string
+	FilterParams() []string
+	Create(ctx context.Context, item P) error
+	Find(ctx context.Context, req *domain.ContentFindReq) (resp []P, count int64, err error)
+	Get(ctx context.Context, id int64, schedule, status string) (resp P, err error)
+	Delete(ctx context.Context, id int64, deletedBy string) error
+	Edit(
+		ctx context.Context, id int64, updatedBy string, updates map[string]interface{},
+	) (resp P, err error)
+	// EditWith is Edit with the updates built from the locked item, for edits that
+	// depend on its current values.
+	EditWith(
+		ctx context.Context, id int64, updatedBy string, build func(item P) (map[string]interface{}, error),
+	) (resp P, err error)
+	Transition(ctx context.Context, req *domain.DiscoverStatusReq) (resp P, err error)
+	Rollback(ctx context.Context, req *domain.DiscoverRollbackReq) (resp P, err error)
+	FindTrash(ctx context.Context, req *domain.DiscoverTrashFindReq) (resp []P, count int64, err error)
+	Restore(ctx context.Context, req *domain.DiscoverRestoreReq) (resp P, err error)
+	Purge(ctx context.Context, deletedBefore time.Time) (count int64, err error)
+	Reorder(ctx context.Context, req *domain.DiscoverReorderReq) (resp []P, err error)
+}
@@ -0,0 +1,824 @@
+package section
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/scope"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// purgeBatchSize bounds how many items are hard-deleted per transaction.
+const purgeBatchSize = 500
+
+// rollbackColumns are the shared columns a rollback restores from a revision snapshot.
+var rollbackColumns = []string{
+	"title", "image_url", "link_url", "targeting", "locales",
+	"position", "publish_at", "expire_at", "updated_by",
+}
+
+type repositoryImpl[T any, P model.ContentPtr[T]] struct {
+	db   *gorm.DB
+	opts Options[P]
+}
+
+// New returns the repository of the section model T, stored following opts.
+func New[T any, P model.ContentPtr[T]](db *gorm.DB, opts Options[P]) Repository[P] {
+	return &repositoryImpl[T, P]{db: db, opts: opts}
+}
+
+func (r *repositoryImpl[T, P]) ItemType() string {
+	return r.opts.ItemType
+}
+
+func (r *repositoryImpl[T, P]) FilterParams() []string {
+	params := make([]string, 0, len(r.opts.Filters))
+	for _, filter := range r.opts.Filters {
+		params = append(params, filter.Param)
+	}
+	return params
+}
+
+func (r *repositoryImpl[T, P]) Create(ctx context.Context, item P) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	base := item.Base()
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.String("title", base.Title),
+		attribute.String("createdBy", base.CreatedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = r.checkTitle(tx, base.Title, 0)
+		if err != nil {
+			return err
+		}
+
+		err = r.validate(tx, item)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Create(item).Error
+		if err != nil {
+			return err
+		}
+
+		return revisions.Record(tx, r.opts.ItemType, base.ID,
+			domain.RevisionActionCreate, base.CreatedBy, "", item)
+	})
+	return err
+}
+
+func (r *repositoryImpl[T, P]) Find(
+	ctx context.Context, req *domain.ContentFindReq,
+) (resp []P, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	var (
+		items  []P
+		total  int64
+		offset = (req.Page - 1) * req.Limit
+	)
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", req.ID),
+		attribute.String("title", req.Title),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+		attribute.String("schedule", req.Schedule),
+	)
+
+	sortBy := req.SortBy
+	if sortBy == "position" {
+		sortBy = "position IS NULL, position"
+	}
+
+	query := r.db.WithContext(ctx).
+		Model(new(T)).
+		Where("deleted_at IS NULL").
+		Scopes(scope.Schedule(req.Schedule, time.Now())).
+		// The id keeps the order stable across pages when the sort column ties.
+		Order(sortBy + " " + req.Order).
+		Order("id")
+
+	if req.ID != 0 {
+		query = query.Where("id = ?", req.ID)
+	}
+	if req.Status != "" {
+		query = query.Where("status = ?", req.Status)
+	}
+	if req.Title != "" {
+		query = query.Where("title LIKE ?", "%"+req.Title+"%")
+	}
+	if len(req.IDs) > 0 {
+		query = query.Where("id IN ?", req.IDs)
+	}
+	if len(req.ExcludeIDs) > 0 {
+		query = query.Where("id NOT IN ?", req.ExcludeIDs)
+	}
+	if req.Audience != nil {
+		query = query.Scopes(scope.Audience(req.Audience))
+	}
+
+	for _, filter := range r.opts.Filters {
+		value := req.Filters[filter.Param]
+		if value == "" {
+			continue
+		}
+
+		if filter.Scope != nil {
+			var filterScope func(db *gorm.DB) *gorm.DB
+			filterScope, err = filter.Scope(value)
+			if err != nil {
+				return nil, 0, err
+			}
+			query = query.Scopes(filterScope)
+			continue
+		}
+
+		var arg interface{} = value
+		if filter.Normalize != nil {
+			arg, err = filter.Normalize(value)
+			if err != nil {
+				return nil, 0, err
+			}
+		}
+		query = query.Where(filter.Where, arg)
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if total == 0 {
+		return []P{}, 0, nil
+	}
+
+	if len(r.opts.ListOmit) > 0 {
+		query = query.Omit(r.opts.ListOmit...)
+	}
+
+	err = query.Limit(int(req.Limit)).
+		Offset(int(offset)).
+		Find(&items).Error
+
+	return items, total, err
+}
+
+func (r *repositoryImpl[T, P]) Get(
+	ctx context.Context, id int64, schedule, status string,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", id),
+		attribute.String("schedule", schedule),
+		attribute.String("status", status),
+	)
+
+	query := r.db.WithContext(ctx).
+		Where("id = ? AND deleted_at IS NULL", id).
+		Scopes(scope.Schedule(schedule, time.Now()))
+
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	item := P(new(T))
+	err = query.First(item).Error
+	if err != nil {
+		return nil, r.notFound(err)
+	}
+
+	return item, nil
+}
+
+func (r *repositoryImpl[T, P]) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	return r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		item := P(new(T))
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			First(item).Error
+		if err != nil {
+			return r.notFound(err)
+		}
+
+		err = tx.Model(item).
+			Updates(map[string]interface{}{
+				"deleted_by": deletedBy,
+				"deleted_at": time.Now(),
+			}).Error
+		if err != nil {
+			return err
+		}
+
+		return revisions.Record(tx, r.opts.ItemType, id,
+			domain.RevisionActionDelete, deletedBy, "", item)
+	})
+}
+
+// Edit applies updates, keyed by column, to a live item. The schedule is checked against
+// the resulting publish window and the title, when changed, against the other live items.
+func (r *repositoryImpl[T, P]) Edit(
+	ctx context.Context, id int64, updatedBy string, updates map[string]interface{},
+) (resp P, err error) {
+	return r.EditWith(ctx, id, updatedBy, func(P) (map[string]interface{}, error) {
+		return updates, nil
+	})
+}
+
+func (r *repositoryImpl[T, P]) EditWith(
+	ctx context.Context, id int64, updatedBy string, build func(item P) (map[string]interface{}, error),
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", id),
+		attribute.String("updatedBy", updatedBy),
+	)
+
+	item := P(new(T))
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", id).
+			First(item).Error
+		if err != nil {
+			return r.notFound(err)
+		}
+
+		base := item.Base()
+		err = editable(base.Status, "editing")
+		if err != nil {
+			return err
+		}
+
+		var updates map[string]interface{}
+		updates, err = build(item)
+		if err != nil {
+			return err
+		}
+
+		// A nil bound in updates removes it.
+		_, setPublishAt := updates["publish_at"]
+		_, setExpireAt := updates["expire_at"]
+		if base.CampaignID != nil && (setPublishAt || setExpireAt) {
+			return eerrs.ErrInCampaign.WrapMsg("the publish window of a campaign item is set on its campaign")
+		}
+
+		publishAt, expireAt := base.PublishAt, base.ExpireAt
+		if setPublishAt {
+			publishAt, _ = updates["publish_at"].(*time.Time)
+		}
+		if setExpireAt {
+			expireAt, _ = updates["expire_at"].(*time.Time)
+		}
+		if !domain.ValidSchedule(publishAt, expireAt) {
+			return errs.ErrArgs.WrapMsg("expireAt must be after publishAt")
+		}
+
+		if title, ok := updates["title"].(string); ok {
+			err = r.checkTitle(tx, title, id)
+			if err != nil {
+				return err
+			}
+		}
+
+		updates["updated_by"] = updatedBy
+
+		err = tx.Model(item).Updates(updates).Error
+		if err != nil {
+			return err
+		}
+
+		err = r.validate(tx, item)
+		if err != nil {
+			return err
+		}
+
+		return revisions.Record(tx, r.opts.ItemType, id,
+			domain.RevisionActionEdit, updatedBy, "", item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repositoryImpl[T, P]) Transition(
+	ctx context.Context, req *domain.DiscoverStatusReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", req.ID),
+		attribute.String("action", req.Action),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	item := P(new(T))
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", req.ID).
+			First(item).Error
+		if err != nil {
+			return r.notFound(err)
+		}
+
+		base := item.Base()
+		from := base.Status
+		to, ok := domain.NextStatus(from, req.Action)
+		if !ok {
+			return eerrs.ErrInvalidStatusTransition.WrapMsg(
+				fmt.Sprintf("cannot %s a %s %s", req.Action, from, r.opts.ItemType))
+		}
+		if domain.IsReviewAction(req.Action) && base.SubmittedBy == req.OperatedBy {
+			return eerrs.ErrForbidden.WrapMsg(
+				fmt.Sprintf("a %s must be reviewed by another admin than its submitter", r.opts.ItemType))
+		}
+
+		updates := map[string]interface{}{
+			"status":     to,
+			"updated_by": req.OperatedBy,
+		}
+		if req.Action == domain.StatusActionSubmit {
+			updates["submitted_by"] = req.OperatedBy
+		}
+		if domain.IsReviewAction(req.Action) {
+			updates["reviewed_by"] = req.OperatedBy
+		}
+
+		err = tx.Model(item).Updates(updates).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&model.DiscoverStatusLogs{
+			ItemType:   r.opts.ItemType,
+			ItemID:     base.ID,
+			Action:     req.Action,
+			FromStatus: from,
+			ToStatus:   to,
+			Note:       req.Note,
+			CreatedBy:  req.OperatedBy,
+		}).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repositoryImpl[T, P]) Rollback(
+	ctx context.Context, req *domain.DiscoverRollbackReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", req.ID),
+		attribute.Int("revision", req.Revision),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	item := P(new(T))
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NULL", req.ID).
+			First(item).Error
+		if err != nil {
+			return r.notFound(err)
+		}
+
+		err = editable(item.Base().Status, "rolling back")
+		if err != nil {
+			return err
+		}
+
+		var revision *model.DiscoverRevisions
+		revision, err = revisions.Get(tx, r.opts.ItemType, req.ID, req.Revision)
+		if err != nil {
+			return err
+		}
+
+		snapshot := P(new(T))
+		err = json.Unmarshal(revision.Snapshot, snapshot)
+		if err != nil {
+			return err
+		}
+
+		err = r.checkTitle(tx, snapshot.Base().Title, req.ID)
+		if err != nil {
+			return err
+		}
+
+		// Selecting the columns also restores the snapshot's empty values. The window
+		// of a campaign item stays with its campaign.
+		if base := item.Base(); base.CampaignID != nil {
+			snapshot.Base().PublishAt, snapshot.Base().ExpireAt = base.PublishAt, base.ExpireAt
+		}
+		snapshot.Base().UpdatedBy = req.OperatedBy
+		err = tx.Model(item).
+			Select(slices.Concat(rollbackColumns, r.opts.Columns)).
+			Updates(snapshot).Error
+		if err != nil {
+			return err
+		}
+
+		err = r.validate(tx, item)
+		if err != nil {
+			return err
+		}
+
+		return revisions.Record(tx, r.opts.ItemType, req.ID, domain.RevisionActionRollback,
+			req.OperatedBy, fmt.Sprintf("restored revision %d", req.Revision), item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repositoryImpl[T, P]) FindTrash(
+	ctx context.Context, req *domain.DiscoverTrashFindReq,
+) (resp []P, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	var (
+		items  []P
+		total  int64
+		offset = (req.Page - 1) * req.Limit
+	)
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+		attribute.String("title", req.Title),
+	)
+
+	query := r.db.WithContext(ctx).
+		Model(new(T)).
+		Where("deleted_at IS NOT NULL").
+		Order("deleted_at DESC")
+
+	if req.Title != "" {
+		query = query.Where("title LIKE ?", "%"+req.Title+"%")
+	}
+
+	err = query.Count(&total).Error
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if len(r.opts.ListOmit) > 0 {
+		query = query.Omit(r.opts.ListOmit...)
+	}
+
+	err = query.Limit(int(req.Limit)).
+		Offset(int(offset)).
+		Find(&items).Error
+
+	return items, total, err
+}
+
+func (r *repositoryImpl[T, P]) Restore(
+	ctx context.Context, req *domain.DiscoverRestoreReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int64("id", req.ID),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	item := P(new(T))
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ? AND deleted_at IS NOT NULL", req.ID).
+			First(item).Error
+		if err != nil {
+			return r.notFound(err)
+		}
+
+		base := item.Base()
+		err = r.checkTitle(tx, base.Title, req.ID)
+		if err != nil {
+			return err
+		}
+
+		if base.Position != nil {
+			var taken int64
+			err = tx.Model(new(T)).
+				Where("position = ? AND id <> ? AND deleted_at IS NULL", *base.Position, req.ID).
+				Count(&taken).Error
+			if err != nil {
+				return err
+			}
+			if taken > 0 {
+				return eerrs.ErrPositionTaken.WrapMsg(fmt.Sprintf("position %d is already used", *base.Position))
+			}
+		}
+
+		err = r.validate(tx, item)
+		if err != nil {
+			return err
+		}
+
+		// A restored item goes back through review before it is visible again.
+		err = tx.Model(item).Updates(map[string]interface{}{
+			"deleted_at": nil,
+			"deleted_by": "",
+			"status":     domain.StatusDraft,
+			"updated_by": req.OperatedBy,
+		}).Error
+		if err != nil {
+			return err
+		}
+
+		return revisions.Record(tx, r.opts.ItemType, req.ID,
+			domain.RevisionActionRestore, req.OperatedBy, "", item)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (r *repositoryImpl[T, P]) Purge(ctx context.Context, deletedBefore time.Time) (count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.String("deletedBefore", deletedBefore.String()),
+	)
+
+	for {
+		var ids []int64
+		err = r.db.WithContext(ctx).
+			Model(new(T)).
+			Where("deleted_at IS NOT NULL AND deleted_at < ?", deletedBefore).
+			Limit(purgeBatchSize).
+			Pluck("id", &ids).Error
+		if err != nil || len(ids) == 0 {
+			return count, err
+		}
+
+		err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if r.opts.Purge != nil {
+				err = r.opts.Purge(tx, ids)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = tx.Where("item_type = ? AND item_id IN ?", r.opts.ItemType, ids).
+				Delete(&model.DiscoverRevisions{}).Error
+			if err != nil {
+				return err
+			}
+
+			err = tx.Where("item_type = ? AND item_id IN ?", r.opts.ItemType, ids).
+				Delete(&model.DiscoverStatusLogs{}).Error
+			if err != nil {
+				return err
+			}
+
+			return tx.Where("id IN ?", ids).Delete(new(T)).Error
+		})
+		if err != nil {
+			return count, err
+		}
+
+		count += int64(len(ids))
+	}
+}
+
+func (r *repositoryImpl[T, P]) Reorder(
+	ctx context.Context, req *domain.DiscoverReorderReq,
+) (resp []P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", r.opts.ItemType),
+		attribute.Int("count", len(req.IDs)),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	err = r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var items []P
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("deleted_at IS NULL").
+			Order("id").
+			Find(&items).Error
+		if err != nil {
+			return err
+		}
+
+		byID := make(map[int64]P, len(items))
+		for _, item := range items {
+			byID[item.Base().ID] = item
+		}
+
+		if len(req.IDs) != len(items) {
+			return eerrs.ErrReorderMismatch.WrapMsg(
+				fmt.Sprintf("expected %d ids, got %d", len(items), len(req.IDs)))
+		}
+
+		resp = make([]P, 0, len(req.IDs))
+		for i, id := range req.IDs {
+			item, ok := byID[id]
+			if !ok {
+				return eerrs.ErrReorderMismatch.WrapMsg(fmt.Sprintf("id %d is unknown or listed twice", id))
+			}
+			delete(byID, id)
+
+			position := i + 1
+			if current := item.Base().Position; current == nil || *current != position {
+				err = tx.Model(item).Updates(map[string]interface{}{
+					"position":   position,
+					"updated_by": req.OperatedBy,
+				}).Error
+				if err != nil {
+					return err
+				}
+			}
+			resp = append(resp, item)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// editable refuses to change the content of items in review or published, so that
+// every version shown to users went through a review.
+func editable(status, action string) error {
+	switch status {
+	case domain.StatusInReview:
+		return eerrs.ErrInvalidStatusTransition.WrapMsg("item is in review, withdraw it before " + action)
+	case domain.StatusPublished:
+		return eerrs.ErrInvalidStatusTransition.WrapMsg("item is published, unpublish it before " + action)
+	}
+	return nil
+}
+
+// notFound maps a missing row to the typed not-found error clients can branch on.
+func (r *repositoryImpl[T, P]) notFound(err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return eerrs.ErrItemNotFound.WrapMsg(r.opts.ItemType + " not found")
+	}
+	return err
+}
+
+// checkTitle fails when the section keeps titles unique and another live item
+// than excludeID already uses title.
+func (r *repositoryImpl[T, P]) checkTitle(tx *gorm.DB, title string, excludeID int64) error {
+	if !r.opts.UniqueTitle {
+		return nil
+	}
+
+	err := tx.Where("title = ? AND id <> ? AND deleted_at IS NULL", title, excludeID).
+		Take(new(T)).Error
+	if err == nil {
+		return fmt.Errorf("title already exists")
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return err
+	}
+
+	return nil
+}
+
+func (r *repositoryImpl[T, P]) validate(tx *gorm.DB, item P) error {
+	if r.opts.Validate == nil {
+		return nil
+	}
+	return r.opts.Validate(tx, item)
+}
@@ -0,0 +1,11 @@
+package statuslogs
+
+import (
+	"context"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	Find(ctx context.Context, itemType string, itemID int64) (resp []*model.DiscoverStatusLogs, err error)
+}
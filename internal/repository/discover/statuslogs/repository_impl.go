@@ -0,0 +1,48 @@
+package statuslogs
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) Find(
+	ctx context.Context, itemType string, itemID int64,
+) (resp []*model.DiscoverStatusLogs, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.Int64("itemID", itemID),
+	)
+
+	err = r.db.WithContext(ctx).
+		Where("item_type = ? AND item_id = ?", itemType, itemID).
+		Order("id DESC").
+		Find(&resp).Error
+
+	return resp, err
+}
@@ -0,0 +1,23 @@
+package trackingevents
+
+import (
+	"context"
+	"time"
+
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+)
+
+type Repository interface {
+	// CreateBatch bulk-inserts tracking events.
+	CreateBatch(ctx context.Context, events []*model.DiscoverTrackingEvents) (err error)
+	// VariantCounts counts the events of an item by variant and kind since the given time,
+	// leaving out the events without a variant.
+	VariantCounts(ctx context.Context, itemType string, itemID int64, since time.Time) (resp []*VariantCount, err error)
+}
+
+// VariantCount is the number of events of one kind reported for a variant.
+type VariantCount struct {
+	Variant string
+	Kind    string
+	Count   int64
+}
@@ -0,0 +1,76 @@
+package trackingevents
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"gorm.io/gorm"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// insertBatchSize bounds the rows of one INSERT statement.
+const insertBatchSize = 500
+
+type repositoryImpl struct {
+	db *gorm.DB
+}
+
+func New(db *gorm.DB) Repository {
+	return &repositoryImpl{db: db}
+}
+
+func (r *repositoryImpl) CreateBatch(ctx context.Context, events []*model.DiscoverTrackingEvents) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("events", len(events)))
+
+	if len(events) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).CreateInBatches(events, insertBatchSize).Error
+}
+
+func (r *repositoryImpl) VariantCounts(
+	ctx context.Context, itemType string, itemID int64, since time.Time,
+) (resp []*VariantCount, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.Int64("itemID", itemID),
+	)
+
+	err = r.db.WithContext(ctx).
+		Model(&model.DiscoverTrackingEvents{}).
+		Select("variant, kind, COUNT(*) AS count").
+		Where("item_type = ? AND item_id = ? AND occurred_at >= ? AND variant <> ''", itemType, itemID, since).
+		Group("variant, kind").
+		Scan(&resp).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,19 @@
+package trending
+
+import (
+	"context"
+	"time"
+)
+
+// Repository counts article opens in hourly buckets and keeps the trending rankings
+// computed from them.
+type Repository interface {
+	// Open counts one open of an article in the bucket of the hour of at.
+	Open(ctx context.Context, articleID int64, at time.Time) (err error)
+	// Opens returns the open counts by article of the buckets of the given hours.
+	Opens(ctx context.Context, hours []time.Time) (resp []map[int64]float64, err error)
+	// SaveRanking replaces the ranking of a window with scores, keeping the best ones.
+	SaveRanking(ctx context.Context, windowHours int, scores map[int64]float64) (err error)
+	// Ranking returns up to size article IDs of the ranking of a window, best first.
+	Ranking(ctx context.Context, windowHours int, size int64) (resp []int64, err error)
+}
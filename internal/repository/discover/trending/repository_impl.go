@@ -0,0 +1,188 @@
+package trending
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyArticleOpens    = "DISCOVER_ARTICLE_OPENS:"
+	CacheKeyTrendingRanking = "DISCOVER_TRENDING_ARTICLES:"
+
+	// opensTTL keeps a bucket as long as the longest window can reach back.
+	opensTTL = (domain.MaxTrendingWindowHours + 1) * time.Hour
+	// rankingTTL drops a ranking the refresh job stopped updating, so the strip falls
+	// back to position order instead of serving it forever.
+	rankingTTL = 24 * time.Hour
+	// rankingSize bounds the articles kept per ranking.
+	rankingSize = 200
+
+	bucketLayout = "2006010215"
+)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Open(ctx context.Context, articleID int64, at time.Time) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("articleID", articleID))
+
+	key := bucketKey(at)
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.ZIncrBy(ctx, key, 1, strconv.FormatInt(articleID, 10))
+		pipe.Expire(ctx, key, opensTTL)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Opens(ctx context.Context, hours []time.Time) (resp []map[int64]float64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int("hours", len(hours)))
+
+	cmds := make([]*redis.ZSliceCmd, len(hours))
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, hour := range hours {
+			cmds[i] = pipe.ZRangeWithScores(ctx, bucketKey(hour), 0, -1)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	resp = make([]map[int64]float64, len(hours))
+	for i, cmd := range cmds {
+		members, cmdErr := cmd.Result()
+		if cmdErr != nil {
+			continue
+		}
+
+		counts := make(map[int64]float64, len(members))
+		for _, member := range members {
+			raw, ok := member.Member.(string)
+			if !ok {
+				continue
+			}
+			articleID, parseErr := strconv.ParseInt(raw, 10, 64)
+			if parseErr != nil {
+				continue
+			}
+			counts[articleID] = member.Score
+		}
+		resp[i] = counts
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) SaveRanking(ctx context.Context, windowHours int, scores map[int64]float64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("windowHours", windowHours),
+		attribute.Int("articles", len(scores)),
+	)
+
+	members := make([]redis.Z, 0, len(scores))
+	for articleID, score := range scores {
+		members = append(members, redis.Z{Score: score, Member: strconv.FormatInt(articleID, 10)})
+	}
+
+	// MULTI/EXEC swaps the ranking at once, readers never see it half written.
+	key := rankingKey(windowHours)
+	_, err = r.rdb.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		if len(members) == 0 {
+			return nil
+		}
+		pipe.ZAdd(ctx, key, members...)
+		pipe.ZRemRangeByRank(ctx, key, 0, -rankingSize-1)
+		pipe.Expire(ctx, key, rankingTTL)
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Ranking(ctx context.Context, windowHours int, size int64) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("windowHours", windowHours),
+		attribute.Int64("size", size),
+	)
+
+	members, err := r.rdb.ZRevRange(ctx, rankingKey(windowHours), 0, size-1).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	resp = make([]int64, 0, len(members))
+	for _, member := range members {
+		articleID, parseErr := strconv.ParseInt(member, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		resp = append(resp, articleID)
+	}
+
+	return resp, nil
+}
+
+func bucketKey(at time.Time) string {
+	return CacheKeyArticleOpens + at.UTC().Format(bucketLayout)
+}
+
+func rankingKey(windowHours int) string {
+	return CacheKeyTrendingRanking + strconv.Itoa(windowHours)
+}
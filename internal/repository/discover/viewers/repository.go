@@ -0,0 +1,33 @@
+package viewers
+
+import (
+	"context"
+	"time"
+)
+
+// Item identifies a tracked discover item.
+type Item struct {
+	Type string
+	ID   int64
+}
+
+// View is a user seeing or clicking an item on a UTC day.
+type View struct {
+	Item
+	Day time.Time
+}
+
+// Repository counts the distinct users of each item per UTC day with Redis
+// HyperLogLogs, which keep a 0.81% standard error in at most 12 KB per key.
+type Repository interface {
+	// Add records a user as a viewer of the items of views, on their day.
+	Add(ctx context.Context, userID string, views []View) (err error)
+	// Items lists the items that had viewers on a day.
+	Items(ctx context.Context, day time.Time) (resp []Item, err error)
+	// Counts returns the approximate viewers of items on a day, in the order of items;
+	// items whose counter expired or was never created count 0.
+	Counts(ctx context.Context, day time.Time, items []Item) (resp []int64, err error)
+	// RangeCounts returns the approximate distinct viewers of items over the UTC days from
+	// from to to inclusive, in the order of items; a user seen on several days counts once.
+	RangeCounts(ctx context.Context, from, to time.Time, items []Item) (resp []int64, err error)
+}
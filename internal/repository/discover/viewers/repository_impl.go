@@ -0,0 +1,208 @@
+package viewers
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+const (
+	CacheKeyItemViewers = "DISCOVER_ITEM_VIEWERS:"
+	// CacheKeyViewedItems indexes the items of a day, so they can be persisted without a SCAN.
+	CacheKeyViewedItems = "DISCOVER_VIEWED_ITEMS:"
+
+	// viewersTTL keeps the counters of a day long enough for the stats rollup to persist
+	// them over its lookback, and no longer.
+	viewersTTL = domain.ViewersRetentionDays * 24 * time.Hour
+
+	dayLayout = "20060102"
+)
+
+type repositoryImpl struct {
+	rdb redis.UniversalClient
+}
+
+func New(rdb redis.UniversalClient) Repository {
+	return &repositoryImpl{rdb: rdb}
+}
+
+func (r *repositoryImpl) Add(ctx context.Context, userID string, views []View) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", userID),
+		attribute.Int("views", len(views)),
+	)
+
+	if userID == "" || len(views) == 0 {
+		return nil
+	}
+
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for _, view := range views {
+			key := viewersKey(view.Day, view.Item)
+			pipe.PFAdd(ctx, key, userID)
+			pipe.Expire(ctx, key, viewersTTL)
+
+			indexKey := CacheKeyViewedItems + view.Day.UTC().Format(dayLayout)
+			pipe.SAdd(ctx, indexKey, member(view.Item))
+			pipe.Expire(ctx, indexKey, viewersTTL)
+		}
+		return nil
+	})
+	return errs.Wrap(err)
+}
+
+func (r *repositoryImpl) Items(ctx context.Context, day time.Time) (resp []Item, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.String("day", day.UTC().Format(dayLayout)))
+
+	members, err := r.rdb.SMembers(ctx, CacheKeyViewedItems+day.UTC().Format(dayLayout)).Result()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	resp = make([]Item, 0, len(members))
+	for _, raw := range members {
+		itemType, rawID, ok := strings.Cut(raw, ":")
+		if !ok {
+			continue
+		}
+		id, parseErr := strconv.ParseInt(rawID, 10, 64)
+		if parseErr != nil {
+			continue
+		}
+		resp = append(resp, Item{Type: itemType, ID: id})
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) Counts(ctx context.Context, day time.Time, items []Item) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("day", day.UTC().Format(dayLayout)),
+		attribute.Int("items", len(items)),
+	)
+
+	resp = make([]int64, len(items))
+	if len(items) == 0 {
+		return resp, nil
+	}
+
+	cmds := make([]*redis.IntCmd, len(items))
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, item := range items {
+			cmds[i] = pipe.PFCount(ctx, viewersKey(day, item))
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	for i, cmd := range cmds {
+		resp[i] = cmd.Val()
+	}
+
+	return resp, nil
+}
+
+func (r *repositoryImpl) RangeCounts(
+	ctx context.Context, from, to time.Time, items []Item,
+) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelRepository).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("from", from.UTC().Format(dayLayout)),
+		attribute.String("to", to.UTC().Format(dayLayout)),
+		attribute.Int("items", len(items)),
+	)
+
+	resp = make([]int64, len(items))
+	if len(items) == 0 {
+		return resp, nil
+	}
+
+	var days []time.Time
+	for day := from.UTC().Truncate(24 * time.Hour); !day.After(to.UTC()); day = day.AddDate(0, 0, 1) {
+		days = append(days, day)
+	}
+
+	// PFCOUNT of several keys counts their union; the keys of an item share a slot.
+	cmds := make([]*redis.IntCmd, len(items))
+	_, err = r.rdb.Pipelined(ctx, func(pipe redis.Pipeliner) error {
+		for i, item := range items {
+			keys := make([]string, 0, len(days))
+			for _, day := range days {
+				keys = append(keys, viewersKey(day, item))
+			}
+			cmds[i] = pipe.PFCount(ctx, keys...)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return nil, errs.Wrap(err)
+	}
+
+	for i, cmd := range cmds {
+		resp[i] = cmd.Val()
+	}
+
+	return resp, nil
+}
+
+// The counters of an item share a hash tag, so that the days of a range are counted
+// together on a cluster.
+func viewersKey(day time.Time, item Item) string {
+	return CacheKeyItemViewers + "{" + member(item) + "}:" + day.UTC().Format(dayLayout)
+}
+
+func member(item Item) string {
+	return item.Type + ":" + strconv.FormatInt(item.ID, 10)
+}
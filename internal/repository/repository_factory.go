@@ -1,10 +1,31 @@
 package repository
 
 import (
+	"github.com/redis/go-redis/v9"
 	"gorm.io/gorm"
 
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/announcements"
 	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/articles"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/campaigns"
 	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/carousels"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/categories"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/dismissals"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/eventrsvps"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/events"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/featuredgroups"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/groupcache"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/itemstats"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pagelayout"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pollcounts"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/polls"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pollvotes"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/quicklinks"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/relatedcache"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/trackingevents"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/trending"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/viewers"
 	health "github.com/1nterdigital/aka-im-discover/internal/repository/health"
 )
 
@@ -12,15 +33,37 @@ type Repository interface {
 	Health() health.Repository
 	DiscoverArticles() articles.Repository
 	DiscoverCarousels() carousels.Repository
+	DiscoverStatusLogs() statuslogs.Repository
+	DiscoverRevisions() revisions.Repository
+	DiscoverCategories() categories.Repository
+	DiscoverAnnouncements() announcements.Repository
+	DiscoverDismissals() dismissals.Repository
+	DiscoverQuickLinks() quicklinks.Repository
+	DiscoverPageLayout() pagelayout.Repository
+	DiscoverPolls() polls.Repository
+	DiscoverPollVotes() pollvotes.Repository
+	DiscoverPollCounts() pollcounts.Repository
+	DiscoverEvents() events.Repository
+	DiscoverEventRSVPs() eventrsvps.Repository
+	DiscoverFeaturedGroups() featuredgroups.Repository
+	DiscoverGroupCache() groupcache.Repository
+	DiscoverCampaigns() campaigns.Repository
+	DiscoverTrending() trending.Repository
+	DiscoverRelatedCache() relatedcache.Repository
+	DiscoverTrackingEvents() trackingevents.Repository
+	DiscoverItemStats() itemstats.Repository
+	DiscoverViewers() viewers.Repository
 }
 
 type repository struct {
-	db *gorm.DB
+	db  *gorm.DB
+	rdb redis.UniversalClient
 }
 
-func NewRepository(db *gorm.DB) Repository {
+func NewRepository(db *gorm.DB, rdb redis.UniversalClient) Repository {
 	return &repository{
-		db: db,
+		db:  db,
+		rdb: rdb,
 	}
 }
 
@@ -35,3 +78,83 @@ func (r *repository) DiscoverCarousels() carousels.Repository {
 func (r *repository) DiscoverArticles() articles.Repository {
 	return articles.New(r.db)
 }
+
+func (r *repository) DiscoverStatusLogs() statuslogs.Repository {
+	return statuslogs.New(r.db)
+}
+
+func (r *repository) DiscoverRevisions() revisions.Repository {
+	return revisions.New(r.db)
+}
+
+func (r *repository) DiscoverCategories() categories.Repository {
+	return categories.New(r.db)
+}
+
+func (r *repository) DiscoverAnnouncements() announcements.Repository {
+	return announcements.New(r.db)
+}
+
+func (r *repository) DiscoverDismissals() dismissals.Repository {
+	return dismissals.New(r.rdb)
+}
+
+func (r *repository) DiscoverQuickLinks() quicklinks.Repository {
+	return quicklinks.New(r.db)
+}
+
+func (r *repository) DiscoverPageLayout() pagelayout.Repository {
+	return pagelayout.New(r.db)
+}
+
+func (r *repository) DiscoverPolls() polls.Repository {
+	return polls.New(r.db, r.DiscoverPollCounts())
+}
+
+func (r *repository) DiscoverPollVotes() pollvotes.Repository {
+	return pollvotes.New(r.db)
+}
+
+func (r *repository) DiscoverPollCounts() pollcounts.Repository {
+	return pollcounts.New(r.rdb)
+}
+
+func (r *repository) DiscoverEvents() events.Repository {
+	return events.New(r.db)
+}
+
+func (r *repository) DiscoverEventRSVPs() eventrsvps.Repository {
+	return eventrsvps.New(r.db)
+}
+
+func (r *repository) DiscoverFeaturedGroups() featuredgroups.Repository {
+	return featuredgroups.New(r.db)
+}
+
+func (r *repository) DiscoverGroupCache() groupcache.Repository {
+	return groupcache.New(r.rdb)
+}
+
+func (r *repository) DiscoverCampaigns() campaigns.Repository {
+	return campaigns.New(r.db)
+}
+
+func (r *repository) DiscoverTrending() trending.Repository {
+	return trending.New(r.rdb)
+}
+
+func (r *repository) DiscoverRelatedCache() relatedcache.Repository {
+	return relatedcache.New(r.rdb)
+}
+
+func (r *repository) DiscoverTrackingEvents() trackingevents.Repository {
+	return trackingevents.New(r.db)
+}
+
+func (r *repository) DiscoverItemStats() itemstats.Repository {
+	return itemstats.New(r.db)
+}
+
+func (r *repository) DiscoverViewers() viewers.Repository {
+	return viewers.New(r.rdb)
+}
@@ -0,0 +1,62 @@
+package usecase
+
+import (
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	discoveryAnnouncements "github.com/1nterdigital/aka-im-discover/internal/repository/discover/announcements"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+)
+
+type DiscoverAnnouncementsUseCase = SectionUseCase[
+	model.DiscoverAnnouncements, *model.DiscoverAnnouncements,
+	domain.DiscoverAnnouncementsAddReq, domain.DiscoverAnnouncementsEditReq,
+]
+
+func NewDiscoverAnnouncementsUseCase(
+	discoverAnnouncementsRepo discoveryAnnouncements.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverAnnouncementsUseCase {
+	return NewSectionUseCase(
+		newAnnouncement, announcementUpdates, discoverAnnouncementsRepo, statusLogsRepo, revisionsRepo,
+	)
+}
+
+func newAnnouncement(req *domain.DiscoverAnnouncementsAddReq) (*model.DiscoverAnnouncements, error) {
+	base, err := newContentBase(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	severity := req.Severity
+	if severity == "" {
+		severity = domain.SeverityInfo
+	}
+
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverAnnouncements{
+		ContentBase: base,
+		Severity:    severity,
+		CTALabel:    req.CTALabel,
+	}, nil
+}
+
+func announcementUpdates(
+	req *domain.DiscoverAnnouncementsEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if req.Severity != "" {
+		updates["severity"] = req.Severity
+	}
+	// An empty label removes the call-to-action button.
+	if req.CTALabel != nil {
+		updates["cta_label"] = *req.CTALabel
+	}
+
+	return req.ID, req.UpdatedBy, updates, nil
+}
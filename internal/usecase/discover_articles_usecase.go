@@ -1,132 +1,88 @@
-//nolint:dupl // similar to other entity
 package usecase
 
 import (
-	"context"
-
-	"go.opentelemetry.io/otel"
-	"go.opentelemetry.io/otel/attribute"
-	"go.opentelemetry.io/otel/codes"
-
 	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	model "github.com/1nterdigital/aka-im-discover/internal/model"
 	discoveryArticles "github.com/1nterdigital/aka-im-discover/internal/repository/discover/articles"
-	"github.com/1nterdigital/aka-im-tools/tracer"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+	"github.com/1nterdigital/aka-im-discover/pkg/util/markdown"
+	"github.com/1nterdigital/aka-im-tools/errs"
 )
 
-type DiscoverArticlesUseCase struct {
-	discoverArticlesRepo discoveryArticles.Repository
-}
+// maxBodyLength caps the Markdown source of an article body, in bytes.
+const maxBodyLength = 64 << 10
 
-func NewDiscoverArticlesUseCase(discoverArticlesRepo discoveryArticles.Repository) *DiscoverArticlesUseCase {
-	return &DiscoverArticlesUseCase{
-		discoverArticlesRepo: discoverArticlesRepo,
-	}
-}
+type DiscoverArticlesUseCase = SectionUseCase[
+	model.DiscoverArticles, *model.DiscoverArticles,
+	domain.DiscoverArticlesAddReq, domain.DiscoverArticlesEditReq,
+]
 
-func (u *DiscoverArticlesUseCase) Create(
-	ctx context.Context, item *domain.DiscoverArticlesAddReq,
-) (resp *model.DiscoverArticles, err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-	}()
-
-	article := &model.DiscoverArticles{
-		Title:     item.Title,
-		ImageURL:  item.ImageURL,
-		LinkURL:   item.LinkURL,
-		CreatedBy: item.CreatedBy,
-		Position:  item.Position,
-	}
-	span.SetAttributes(
-		attribute.String("title", article.Title),
-		attribute.String("createdBy", article.CreatedBy),
-	)
+func NewDiscoverArticlesUseCase(
+	discoverArticlesRepo discoveryArticles.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverArticlesUseCase {
+	return NewSectionUseCase(newArticle, articleUpdates, discoverArticlesRepo, statusLogsRepo, revisionsRepo)
+}
 
-	err = u.discoverArticlesRepo.Create(ctx, article)
+func newArticle(req *domain.DiscoverArticlesAddReq) (*model.DiscoverArticles, error) {
+	base, err := newContentBase(req.Content())
 	if err != nil {
 		return nil, err
 	}
 
-	return article, nil
-}
-func (u *DiscoverArticlesUseCase) Find(
-	ctx context.Context, req *domain.DiscoverArticlesFindReq,
-) (resp []*model.DiscoverArticles, total int64, err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-	}()
+	tags, ok := domain.NormalizeTags(req.Tags)
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("tags must be at most 10 non-empty values of up to 32 characters")
+	}
 
-	span.SetAttributes(
-		attribute.Int64("articleID", req.ID),
-		attribute.String("title", req.Title),
-		attribute.Int("page", int(req.Page)),
-		attribute.Int("limit", int(req.Limit)),
-	)
+	if len(req.Body) > maxBodyLength {
+		return nil, errs.ErrArgs.WrapMsg("body must be at most 64KB")
+	}
 
-	resp, total, err = u.discoverArticlesRepo.Find(ctx, req)
-	return resp, total, err
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverArticles{
+		ContentBase: base,
+		Body:        req.Body,
+		BodyHTML:    markdown.Render(req.Body),
+		CategoryID:  req.CategoryID,
+		Tags:        tags,
+	}, nil
 }
 
-func (u *DiscoverArticlesUseCase) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.Int64("articleID", id),
-		attribute.String("deletedBy", deletedBy),
-	)
+func articleUpdates(
+	req *domain.DiscoverArticlesEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
 
-	err = u.discoverArticlesRepo.Delete(ctx, id, deletedBy)
-	return err
-}
+	tags, ok := domain.NormalizeTags(req.Tags)
+	if !ok {
+		return 0, "", nil, errs.ErrArgs.WrapMsg("tags must be at most 10 non-empty values of up to 32 characters")
+	}
+	if tags != nil {
+		updates["tags"] = model.Tags(tags)
+	}
 
-func (u *DiscoverArticlesUseCase) Edit(
-	ctx context.Context, item *domain.DiscoverArticlesEditReq,
-) (resp *model.DiscoverArticles, err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
+	if req.Body != nil {
+		if len(*req.Body) > maxBodyLength {
+			return 0, "", nil, errs.ErrArgs.WrapMsg("body must be at most 64KB")
 		}
-		span.End()
-	}()
+		updates["body"] = *req.Body
+		updates["body_html"] = markdown.Render(*req.Body)
+	}
 
-	article := model.DiscoverArticles{
-		ID:        item.ID,
-		Title:     item.Title,
-		ImageURL:  item.ImageURL,
-		LinkURL:   item.LinkURL,
-		UpdatedBy: item.UpdatedBy,
-		Position:  item.Position,
+	if req.CategoryID != nil {
+		// A zero category ID takes the article out of its category.
+		if *req.CategoryID == 0 {
+			updates["category_id"] = nil
+		} else {
+			updates["category_id"] = req.CategoryID
+		}
 	}
-	span.SetAttributes(
-		attribute.Int64("articleID", item.ID),
-		attribute.String("title", item.Title),
-		attribute.String("updatedBy", item.UpdatedBy),
-	)
 
-	resp, err = u.discoverArticlesRepo.Edit(ctx, &article)
-	return resp, err
+	return req.ID, req.UpdatedBy, updates, nil
 }
@@ -0,0 +1,274 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/campaigns"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverCampaignsUseCase struct {
+	campaignsRepo campaigns.Repository
+}
+
+func NewDiscoverCampaignsUseCase(campaignsRepo campaigns.Repository) *DiscoverCampaignsUseCase {
+	return &DiscoverCampaignsUseCase{
+		campaignsRepo: campaignsRepo,
+	}
+}
+
+func (u *DiscoverCampaignsUseCase) Create(
+	ctx context.Context, req *domain.DiscoverCampaignsAddReq,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("name", req.Name),
+		attribute.String("createdBy", req.CreatedBy),
+	)
+
+	if !domain.ValidSchedule(req.PublishAt, req.ExpireAt) {
+		return nil, errs.ErrArgs.WrapMsg("expireAt must be after publishAt")
+	}
+
+	campaign := &model.DiscoverCampaigns{
+		Name:      req.Name,
+		PublishAt: req.PublishAt,
+		ExpireAt:  req.ExpireAt,
+		CreatedBy: req.CreatedBy,
+		UpdatedBy: req.CreatedBy,
+	}
+	err = u.campaignsRepo.Create(ctx, campaign)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.withItems(ctx, campaign)
+	if err != nil {
+		return nil, err
+	}
+	return campaign, nil
+}
+
+// Find lists campaigns, newest first, each with its items and combined status.
+func (u *DiscoverCampaignsUseCase) Find(
+	ctx context.Context, req *domain.DiscoverCampaignsFindReq,
+) (resp []*model.DiscoverCampaigns, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("name", req.Name),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+	)
+
+	resp, count, err = u.campaignsRepo.Find(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = u.withItems(ctx, resp...)
+	if err != nil {
+		return nil, 0, err
+	}
+	return resp, count, nil
+}
+
+func (u *DiscoverCampaignsUseCase) Get(ctx context.Context, id int64) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("campaignID", id))
+
+	resp, err = u.campaignsRepo.Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.withItems(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (u *DiscoverCampaignsUseCase) Edit(
+	ctx context.Context, req *domain.DiscoverCampaignsEditReq,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("campaignID", req.ID),
+		attribute.String("updatedBy", req.UpdatedBy),
+	)
+
+	resp, err = u.campaignsRepo.Edit(ctx, &model.DiscoverCampaigns{
+		ID:        req.ID,
+		Name:      req.Name,
+		PublishAt: req.PublishAt,
+		ExpireAt:  req.ExpireAt,
+		UpdatedBy: req.UpdatedBy,
+	}, req.ClearPublishAt, req.ClearExpireAt)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.withItems(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func (u *DiscoverCampaignsUseCase) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("campaignID", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	return u.campaignsRepo.Delete(ctx, id, deletedBy)
+}
+
+// SetPaused pauses or resumes all items of a campaign at once.
+func (u *DiscoverCampaignsUseCase) SetPaused(
+	ctx context.Context, req *domain.DiscoverCampaignPauseReq, paused bool,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("campaignID", req.ID),
+		attribute.Bool("paused", paused),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	resp, err = u.campaignsRepo.SetPaused(ctx, req.ID, paused, req.OperatedBy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.withItems(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// EditItems adds the items of req to the campaign, or removes them when remove is set,
+// and returns the campaign with its new members.
+func (u *DiscoverCampaignsUseCase) EditItems(
+	ctx context.Context, req *domain.DiscoverCampaignItemsReq, remove bool,
+) (resp *model.DiscoverCampaigns, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("campaignID", req.ID),
+		attribute.Int("count", len(req.Items)),
+		attribute.Bool("remove", remove),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	if remove {
+		err = u.campaignsRepo.RemoveItems(ctx, req)
+	} else {
+		err = u.campaignsRepo.AddItems(ctx, req)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return u.Get(ctx, req.ID)
+}
+
+// withItems fills the members and the combined status of the campaigns.
+func (u *DiscoverCampaignsUseCase) withItems(ctx context.Context, items ...*model.DiscoverCampaigns) error {
+	ids := make([]int64, 0, len(items))
+	for _, item := range items {
+		ids = append(ids, item.ID)
+	}
+
+	members, err := u.campaignsRepo.Items(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, item := range items {
+		item.Items = members[item.ID]
+		if item.Items == nil {
+			item.Items = []*model.CampaignItem{}
+		}
+
+		statuses := make([]string, 0, len(item.Items))
+		for _, member := range item.Items {
+			statuses = append(statuses, member.Status)
+		}
+		item.Status = domain.CampaignStatus(item.Paused, item.PublishAt, item.ExpireAt, statuses, now)
+	}
+
+	return nil
+}
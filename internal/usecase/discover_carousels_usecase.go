@@ -1,8 +1,10 @@
-//nolint:dupl // similar to other entity
 package usecase
 
 import (
 	"context"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/attribute"
@@ -11,22 +13,35 @@ import (
 	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	model "github.com/1nterdigital/aka-im-discover/internal/model"
 	discoveryCarousels "github.com/1nterdigital/aka-im-discover/internal/repository/discover/carousels"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+	"github.com/1nterdigital/aka-im-tools/errs"
 	"github.com/1nterdigital/aka-im-tools/tracer"
 )
 
 type DiscoverCarouselsUseCase struct {
+	*SectionUseCase[
+		model.DiscoverCarousels, *model.DiscoverCarousels,
+		domain.DiscoverCarouselsAddReq, domain.DiscoverCarouselsEditReq,
+	]
 	discoverCarouselsRepo discoveryCarousels.Repository
 }
 
-func NewDiscoverCarouselsUseCase(discoverCarouselsRepo discoveryCarousels.Repository) *DiscoverCarouselsUseCase {
+func NewDiscoverCarouselsUseCase(
+	discoverCarouselsRepo discoveryCarousels.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverCarouselsUseCase {
 	return &DiscoverCarouselsUseCase{
+		SectionUseCase:        NewSectionUseCase(newCarousel, carouselUpdates, discoverCarouselsRepo, statusLogsRepo, revisionsRepo),
 		discoverCarouselsRepo: discoverCarouselsRepo,
 	}
 }
 
-func (u *DiscoverCarouselsUseCase) Create(
-	ctx context.Context,
-	item *domain.DiscoverCarouselsAddReq,
+// Promote makes a variant of the carousel's experiment the slide's own and ends the
+// experiment.
+func (u *DiscoverCarouselsUseCase) Promote(
+	ctx context.Context, req *domain.DiscoverCarouselPromoteReq,
 ) (resp *model.DiscoverCarousels, err error) {
 	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
 		Start(ctx, tracer.GetFullFunctionPath())
@@ -38,97 +53,83 @@ func (u *DiscoverCarouselsUseCase) Create(
 		span.End()
 	}()
 
-	carousel := &model.DiscoverCarousels{
-		Title:     item.Title,
-		ImageURL:  item.ImageURL,
-		LinkURL:   item.LinkURL,
-		CreatedBy: item.CreatedBy,
-		Position:  item.Position,
-	}
 	span.SetAttributes(
-		attribute.String("title", carousel.Title),
-		attribute.String("createdBy", carousel.CreatedBy),
+		attribute.Int64("id", req.ID),
+		attribute.String("experimentID", req.ExperimentID),
+		attribute.String("key", req.Key),
+		attribute.String("operatedBy", req.OperatedBy),
 	)
 
-	err = u.discoverCarouselsRepo.Create(ctx, carousel)
+	resp, err = u.discoverCarouselsRepo.Promote(ctx, req)
+	return resp, err
+}
+
+func newCarousel(req *domain.DiscoverCarouselsAddReq) (*model.DiscoverCarousels, error) {
+	base, err := newContentBase(req.Content())
 	if err != nil {
 		return nil, err
 	}
 
-	return carousel, nil
-}
-
-func (u *DiscoverCarouselsUseCase) Find(
-	ctx context.Context, req *domain.DiscoverCarouselsFindReq,
-) (resp []*model.DiscoverCarousels, count int64, err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.Int64("carouselID", req.ID),
-		attribute.String("title", req.Title),
-		attribute.Int("page", int(req.Page)),
-		attribute.Int("limit", int(req.Limit)),
-	)
+	experiment, err := toModelExperiment(req.Variants)
+	if err != nil {
+		return nil, err
+	}
 
-	resp, count, err = u.discoverCarouselsRepo.Find(ctx, req)
-	return resp, count, err
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverCarousels{ContentBase: base, Experiment: experiment}, nil
 }
 
-func (u *DiscoverCarouselsUseCase) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
+func carouselUpdates(
+	req *domain.DiscoverCarouselsEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if req.Variants != nil {
+		var experiment *model.CarouselExperiment
+		experiment, err = toModelExperiment(req.Variants)
 		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
+			return 0, "", nil, err
 		}
-		span.End()
-	}()
-
-	span.SetAttributes(
-		attribute.Int64("carouselID", id),
-		attribute.String("deletedBy", deletedBy),
-	)
+		updates["experiment"] = experiment
+	}
 
-	err = u.discoverCarouselsRepo.Delete(ctx, id, deletedBy)
-	return err
+	return req.ID, req.UpdatedBy, updates, nil
 }
 
-func (u *DiscoverCarouselsUseCase) Edit(
-	ctx context.Context, item *domain.DiscoverCarouselsEditReq,
-) (resp *model.DiscoverCarousels, err error) {
-	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
-		Start(ctx, tracer.GetFullFunctionPath())
-	defer func() {
-		if err != nil {
-			span.RecordError(err)
-			span.SetStatus(codes.Error, err.Error())
-		}
-		span.End()
-	}()
+// toModelExperiment starts a new experiment between variants; no variants means none.
+func toModelExperiment(variants []domain.DiscoverCarouselVariant) (*model.CarouselExperiment, error) {
+	if len(variants) == 0 {
+		return nil, nil
+	}
+	if len(variants) == 1 {
+		return nil, errs.ErrArgs.WrapMsg("an experiment needs at least two variants")
+	}
 
-	carousel := model.DiscoverCarousels{
-		ID:        item.ID,
-		Title:     item.Title,
-		ImageURL:  item.ImageURL,
-		LinkURL:   item.LinkURL,
-		UpdatedBy: item.UpdatedBy,
-		Position:  item.Position,
+	now := time.Now()
+	experiment := &model.CarouselExperiment{
+		ID:        strconv.FormatInt(now.UnixNano(), 36),
+		StartedAt: now,
+		Variants:  make([]model.CarouselVariant, 0, len(variants)),
+	}
+	for _, variant := range variants {
+		key := strings.TrimSpace(variant.Key)
+		if key == "" {
+			return nil, errs.ErrArgs.WrapMsg("variant keys must not be blank")
+		}
+		if _, ok := experiment.Variant(key); ok {
+			return nil, errs.ErrArgs.WrapMsg("duplicate variant key " + key)
+		}
+		experiment.Variants = append(experiment.Variants, model.CarouselVariant{
+			Key:      key,
+			Title:    variant.Title,
+			ImageURL: variant.ImageURL,
+			LinkURL:  variant.LinkURL,
+			Weight:   variant.Weight,
+		})
 	}
-	span.SetAttributes(
-		attribute.Int64("carouselID", carousel.ID),
-		attribute.String("title", carousel.Title),
-		attribute.String("updatedBy", carousel.UpdatedBy),
-	)
 
-	resp, err = u.discoverCarouselsRepo.Edit(ctx, &carousel)
-	return resp, err
+	return experiment, nil
 }
@@ -0,0 +1,172 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/categories"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverCategoriesUseCase struct {
+	discoverCategoriesRepo categories.Repository
+}
+
+func NewDiscoverCategoriesUseCase(discoverCategoriesRepo categories.Repository) *DiscoverCategoriesUseCase {
+	return &DiscoverCategoriesUseCase{
+		discoverCategoriesRepo: discoverCategoriesRepo,
+	}
+}
+
+func (u *DiscoverCategoriesUseCase) Create(
+	ctx context.Context, item *domain.DiscoverCategoriesAddReq,
+) (resp *model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	slug, ok := domain.NormalizeSlug(item.Slug)
+	if !ok {
+		return nil, errs.ErrArgs.WrapMsg("slug may only contain letters, digits, '-' and '_'")
+	}
+
+	locales, err := toModelLocales(item.Locales)
+	if err != nil {
+		return nil, err
+	}
+
+	category := &model.DiscoverCategories{
+		Slug:      slug,
+		Name:      item.Name,
+		IconURL:   item.IconURL,
+		Locales:   locales,
+		Position:  item.Position,
+		CreatedBy: item.CreatedBy,
+	}
+	span.SetAttributes(
+		attribute.String("slug", category.Slug),
+		attribute.String("createdBy", category.CreatedBy),
+	)
+
+	err = u.discoverCategoriesRepo.Create(ctx, category)
+	if err != nil {
+		return nil, err
+	}
+
+	return category, nil
+}
+
+// Find lists categories; liveOnly restricts the article counts to what the public sees.
+func (u *DiscoverCategoriesUseCase) Find(
+	ctx context.Context, liveOnly bool,
+) (resp []*model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Bool("liveOnly", liveOnly))
+
+	resp, err = u.discoverCategoriesRepo.Find(ctx, liveOnly)
+	return resp, err
+}
+
+func (u *DiscoverCategoriesUseCase) Get(ctx context.Context, id int64) (resp *model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("categoryID", id))
+
+	resp, err = u.discoverCategoriesRepo.Get(ctx, id)
+	return resp, err
+}
+
+func (u *DiscoverCategoriesUseCase) Edit(
+	ctx context.Context, item *domain.DiscoverCategoriesEditReq,
+) (resp *model.DiscoverCategories, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	slug := item.Slug
+	if slug != "" {
+		var ok bool
+		slug, ok = domain.NormalizeSlug(slug)
+		if !ok {
+			return nil, errs.ErrArgs.WrapMsg("slug may only contain letters, digits, '-' and '_'")
+		}
+	}
+
+	locales, err := toModelLocales(item.Locales)
+	if err != nil {
+		return nil, err
+	}
+
+	category := model.DiscoverCategories{
+		ID:        item.ID,
+		Slug:      slug,
+		Name:      item.Name,
+		IconURL:   item.IconURL,
+		Locales:   locales,
+		Position:  item.Position,
+		UpdatedBy: item.UpdatedBy,
+	}
+	span.SetAttributes(
+		attribute.Int64("categoryID", item.ID),
+		attribute.String("slug", slug),
+		attribute.String("updatedBy", item.UpdatedBy),
+	)
+
+	resp, err = u.discoverCategoriesRepo.Edit(ctx, &category)
+	return resp, err
+}
+
+func (u *DiscoverCategoriesUseCase) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("categoryID", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	err = u.discoverCategoriesRepo.Delete(ctx, id, deletedBy)
+	return err
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/dismissals"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverDismissalsUseCase struct {
+	discoverDismissalsRepo dismissals.Repository
+}
+
+func NewDiscoverDismissalsUseCase(discoverDismissalsRepo dismissals.Repository) *DiscoverDismissalsUseCase {
+	return &DiscoverDismissalsUseCase{
+		discoverDismissalsRepo: discoverDismissalsRepo,
+	}
+}
+
+// Dismiss hides an item of the section from the user for good.
+func (u *DiscoverDismissalsUseCase) Dismiss(
+	ctx context.Context, itemType, userID string, id int64,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.String("userID", userID),
+		attribute.Int64("id", id),
+	)
+
+	return u.discoverDismissalsRepo.Dismiss(ctx, itemType, userID, id)
+}
+
+// Find returns the IDs of the items of the section the user dismissed.
+func (u *DiscoverDismissalsUseCase) Find(
+	ctx context.Context, itemType, userID string,
+) (ids []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", itemType),
+		attribute.String("userID", userID),
+	)
+
+	return u.discoverDismissalsRepo.Find(ctx, itemType, userID)
+}
@@ -0,0 +1,123 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/eventrsvps"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverEventRSVPsUseCase struct {
+	eventRSVPsRepo eventrsvps.Repository
+}
+
+func NewDiscoverEventRSVPsUseCase(eventRSVPsRepo eventrsvps.Repository) *DiscoverEventRSVPsUseCase {
+	return &DiscoverEventRSVPsUseCase{
+		eventRSVPsRepo: eventRSVPsRepo,
+	}
+}
+
+// Join takes a seat of the event for the user, as long as the event has not ended.
+func (u *DiscoverEventRSVPsUseCase) Join(
+	ctx context.Context, event *model.DiscoverEvents, userID string,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("eventID", event.ID),
+		attribute.String("userID", userID),
+	)
+
+	if event.HasEnded(time.Now()) {
+		return eerrs.ErrEventEnded.WrapMsg("event has ended")
+	}
+
+	return u.eventRSVPsRepo.Join(ctx, event.ID, userID)
+}
+
+// Cancel frees the seat of the user, as long as the event has not ended.
+func (u *DiscoverEventRSVPsUseCase) Cancel(
+	ctx context.Context, event *model.DiscoverEvents, userID string,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("eventID", event.ID),
+		attribute.String("userID", userID),
+	)
+
+	if event.HasEnded(time.Now()) {
+		return eerrs.ErrEventEnded.WrapMsg("event has ended")
+	}
+
+	return u.eventRSVPsRepo.Cancel(ctx, event.ID, userID)
+}
+
+func (u *DiscoverEventRSVPsUseCase) FindEvents(
+	ctx context.Context, userID string, page, limit int32,
+) (resp []*model.DiscoverEvents, count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("userID", userID),
+		attribute.Int("page", int(page)),
+		attribute.Int("limit", int(limit)),
+	)
+
+	return u.eventRSVPsRepo.FindEvents(ctx, userID, page, limit)
+}
+
+func (u *DiscoverEventRSVPsUseCase) Joined(
+	ctx context.Context, userID string, events []*model.DiscoverEvents,
+) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.String("userID", userID))
+
+	eventIDs := make([]int64, 0, len(events))
+	for _, event := range events {
+		eventIDs = append(eventIDs, event.ID)
+	}
+
+	return u.eventRSVPsRepo.Joined(ctx, userID, eventIDs)
+}
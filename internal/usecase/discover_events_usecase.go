@@ -0,0 +1,66 @@
+package usecase
+
+import (
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	discoveryEvents "github.com/1nterdigital/aka-im-discover/internal/repository/discover/events"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+)
+
+type DiscoverEventsUseCase = SectionUseCase[
+	model.DiscoverEvents, *model.DiscoverEvents,
+	domain.DiscoverEventsAddReq, domain.DiscoverEventsEditReq,
+]
+
+func NewDiscoverEventsUseCase(
+	discoverEventsRepo discoveryEvents.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverEventsUseCase {
+	return NewSectionUseCase(newEvent, eventUpdates, discoverEventsRepo, statusLogsRepo, revisionsRepo)
+}
+
+func newEvent(req *domain.DiscoverEventsAddReq) (*model.DiscoverEvents, error) {
+	base, err := newContentBase(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverEvents{
+		ContentBase: base,
+		Location:    req.Location,
+		StartsAt:    *req.StartsAt,
+		EndsAt:      *req.EndsAt,
+		Capacity:    req.Capacity,
+	}, nil
+}
+
+func eventUpdates(
+	req *domain.DiscoverEventsEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if req.Location != nil {
+		updates["location"] = *req.Location
+	}
+	if req.StartsAt != nil {
+		updates["starts_at"] = *req.StartsAt
+	}
+	if req.EndsAt != nil {
+		updates["ends_at"] = *req.EndsAt
+	}
+	if req.Capacity != nil {
+		if *req.Capacity == 0 {
+			updates["capacity"] = nil
+		} else {
+			updates["capacity"] = *req.Capacity
+		}
+	}
+
+	return req.ID, req.UpdatedBy, updates, nil
+}
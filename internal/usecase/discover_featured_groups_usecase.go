@@ -0,0 +1,52 @@
+package usecase
+
+import (
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/featuredgroups"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+)
+
+type DiscoverFeaturedGroupsUseCase = SectionUseCase[
+	model.DiscoverFeaturedGroups, *model.DiscoverFeaturedGroups,
+	domain.DiscoverFeaturedGroupsAddReq, domain.DiscoverFeaturedGroupsEditReq,
+]
+
+func NewDiscoverFeaturedGroupsUseCase(
+	discoverFeaturedGroupsRepo featuredgroups.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverFeaturedGroupsUseCase {
+	return NewSectionUseCase(
+		newFeaturedGroup, featuredGroupUpdates, discoverFeaturedGroupsRepo, statusLogsRepo, revisionsRepo,
+	)
+}
+
+func newFeaturedGroup(req *domain.DiscoverFeaturedGroupsAddReq) (*model.DiscoverFeaturedGroups, error) {
+	base, err := newContentBase(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverFeaturedGroups{
+		ContentBase: base,
+		GroupID:     req.GroupID,
+	}, nil
+}
+
+func featuredGroupUpdates(
+	req *domain.DiscoverFeaturedGroupsEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if req.GroupID != "" {
+		updates["group_id"] = req.GroupID
+	}
+
+	return req.ID, req.UpdatedBy, updates, nil
+}
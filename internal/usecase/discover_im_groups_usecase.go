@@ -0,0 +1,103 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/groupcache"
+	"github.com/1nterdigital/aka-im-discover/pkg/common/imapi"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// DiscoverIMGroupsUseCase resolves the live state of IM groups through the IM API,
+// behind a short-lived cache.
+type DiscoverIMGroupsUseCase struct {
+	imApiCaller    imapi.CallerInterface
+	groupCacheRepo groupcache.Repository
+}
+
+func NewDiscoverIMGroupsUseCase(
+	imApiCaller imapi.CallerInterface,
+	groupCacheRepo groupcache.Repository,
+) *DiscoverIMGroupsUseCase {
+	return &DiscoverIMGroupsUseCase{
+		imApiCaller:    imApiCaller,
+		groupCacheRepo: groupCacheRepo,
+	}
+}
+
+// Resolve returns, keyed by group ID, the groups among groupIDs that may be shown.
+// Groups the IM no longer knows, dismissed groups and private groups are left out.
+func (u *DiscoverIMGroupsUseCase) Resolve(
+	ctx context.Context, groupIDs []string,
+) (resp map[string]*model.IMGroup, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.StringSlice("groupIDs", groupIDs))
+
+	groups, err := u.groupCacheRepo.Find(ctx, groupIDs)
+	if err != nil {
+		log.ZWarn(ctx, "group cache read failed", err)
+		groups = map[string]*model.IMGroup{}
+	}
+
+	missing := make([]string, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		if _, ok := groups[groupID]; !ok {
+			missing = append(missing, groupID)
+		}
+	}
+
+	if len(missing) > 0 {
+		infos, findErr := u.imApiCaller.FindGroupInfo(ctx, missing)
+		if findErr != nil {
+			return nil, findErr
+		}
+
+		fetched := make(map[string]*model.IMGroup, len(missing))
+		for _, groupID := range missing {
+			fetched[groupID] = nil
+		}
+		for _, info := range infos {
+			if _, ok := fetched[info.GroupID]; !ok || !info.IsPublic() {
+				continue
+			}
+			fetched[info.GroupID] = &model.IMGroup{
+				GroupID:     info.GroupID,
+				Name:        info.GroupName,
+				FaceURL:     info.FaceURL,
+				MemberCount: info.MemberCount,
+			}
+		}
+
+		if saveErr := u.groupCacheRepo.Save(ctx, fetched); saveErr != nil {
+			log.ZWarn(ctx, "group cache write failed", saveErr)
+		}
+		for groupID, group := range fetched {
+			groups[groupID] = group
+		}
+	}
+
+	resp = make(map[string]*model.IMGroup, len(groups))
+	for groupID, group := range groups {
+		if group != nil {
+			resp[groupID] = group
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,83 @@
+package usecase
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pagelayout"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverPageLayoutUseCase struct {
+	pageLayoutRepo pagelayout.Repository
+}
+
+func NewDiscoverPageLayoutUseCase(pageLayoutRepo pagelayout.Repository) *DiscoverPageLayoutUseCase {
+	return &DiscoverPageLayoutUseCase{
+		pageLayoutRepo: pageLayoutRepo,
+	}
+}
+
+func (u *DiscoverPageLayoutUseCase) Find(ctx context.Context) (resp []*model.DiscoverPageSections, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	return u.pageLayoutRepo.Find(ctx)
+}
+
+// Save replaces the layout with the sections of req, in their order. A section may
+// only appear once.
+func (u *DiscoverPageLayoutUseCase) Save(
+	ctx context.Context, req *domain.DiscoverPageLayoutReq,
+) (resp []*model.DiscoverPageSections, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("sections", len(req.Sections)),
+		attribute.String("updatedBy", req.UpdatedBy),
+	)
+
+	seen := make(map[string]struct{}, len(req.Sections))
+	resp = make([]*model.DiscoverPageSections, 0, len(req.Sections))
+	for i, section := range req.Sections {
+		if _, ok := seen[section.Section]; ok {
+			return nil, errs.ErrArgs.WrapMsg("section " + section.Section + " appears more than once")
+		}
+		seen[section.Section] = struct{}{}
+
+		resp = append(resp, &model.DiscoverPageSections{
+			Section:   section.Section,
+			Position:  i + 1,
+			ItemLimit: section.Limit,
+			CreatedBy: req.UpdatedBy,
+		})
+	}
+
+	err = u.pageLayoutRepo.Replace(ctx, resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,182 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pollcounts"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/pollvotes"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverPollVotesUseCase struct {
+	pollVotesRepo  pollvotes.Repository
+	pollCountsRepo pollcounts.Repository
+}
+
+func NewDiscoverPollVotesUseCase(
+	pollVotesRepo pollvotes.Repository,
+	pollCountsRepo pollcounts.Repository,
+) *DiscoverPollVotesUseCase {
+	return &DiscoverPollVotesUseCase{
+		pollVotesRepo:  pollVotesRepo,
+		pollCountsRepo: pollCountsRepo,
+	}
+}
+
+// Vote records the vote of the user on an open poll and returns the results, now
+// visible to the user.
+func (u *DiscoverPollVotesUseCase) Vote(
+	ctx context.Context, poll *model.DiscoverPolls, userID string, optionIDs []int64,
+) (resp *domain.DiscoverPollResults, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", poll.ID),
+		attribute.String("userID", userID),
+		attribute.Int64Slice("optionIDs", optionIDs),
+	)
+
+	if !poll.IsOpen(time.Now()) {
+		return nil, eerrs.ErrPollClosed.WrapMsg("poll is not taking votes")
+	}
+
+	optionIDs = slices.Compact(slices.Sorted(slices.Values(optionIDs)))
+	if !poll.MultiChoice && len(optionIDs) > 1 {
+		return nil, errs.ErrArgs.WrapMsg("poll only allows a single choice")
+	}
+	for _, optionID := range optionIDs {
+		if _, ok := poll.Option(optionID); !ok {
+			return nil, errs.ErrArgs.WrapMsg("unknown poll option")
+		}
+	}
+
+	vote := &model.DiscoverPollVotes{
+		PollID:    poll.ID,
+		UserID:    userID,
+		OptionIDs: optionIDs,
+	}
+	err = u.pollVotesRepo.Create(ctx, vote)
+	if err != nil {
+		return nil, err
+	}
+
+	// The vote is stored; counts that missed it are dropped and rebuilt on the next read.
+	if incrErr := u.pollCountsRepo.Incr(ctx, poll.ID, optionIDs); incrErr != nil {
+		log.ZError(ctx, "poll count increment failed", incrErr, "pollID", poll.ID)
+		if dropErr := u.pollCountsRepo.Drop(ctx, poll.ID); dropErr != nil {
+			log.ZError(ctx, "poll count drop failed", dropErr, "pollID", poll.ID)
+		}
+	}
+
+	return u.results(ctx, poll, vote)
+}
+
+// Results returns the results of a poll for the user; the counts are only included
+// once the user has voted.
+func (u *DiscoverPollVotesUseCase) Results(
+	ctx context.Context, poll *model.DiscoverPolls, userID string,
+) (resp *domain.DiscoverPollResults, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int64("pollID", poll.ID),
+		attribute.String("userID", userID),
+	)
+
+	vote, err := u.pollVotesRepo.Get(ctx, poll.ID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return u.results(ctx, poll, vote)
+}
+
+func (u *DiscoverPollVotesUseCase) results(
+	ctx context.Context, poll *model.DiscoverPolls, vote *model.DiscoverPollVotes,
+) (*domain.DiscoverPollResults, error) {
+	resp := &domain.DiscoverPollResults{
+		PollID:  poll.ID,
+		Options: make([]domain.DiscoverPollOptionResult, 0, len(poll.Options)),
+	}
+	for _, option := range poll.Options {
+		resp.Options = append(resp.Options, domain.DiscoverPollOptionResult{ID: option.ID, Label: option.Label})
+	}
+	if vote == nil {
+		return resp, nil
+	}
+
+	counts, voters, err := u.counts(ctx, poll.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp.Voted = true
+	resp.Selected = vote.OptionIDs
+	resp.TotalVotes = &voters
+	for i := range resp.Options {
+		votes := counts[resp.Options[i].ID]
+		resp.Options[i].Votes = &votes
+	}
+
+	return resp, nil
+}
+
+// counts reads the live counts of a poll, rebuilding them from the stored votes when
+// they are not loaded and counting the stored votes when Redis is unavailable.
+func (u *DiscoverPollVotesUseCase) counts(
+	ctx context.Context, pollID int64,
+) (counts map[int64]int64, voters int64, err error) {
+	counts, voters, ok, err := u.pollCountsRepo.Get(ctx, pollID)
+	if err != nil {
+		log.ZWarn(ctx, "poll count read failed", err, "pollID", pollID)
+		return u.pollVotesRepo.Count(ctx, pollID)
+	}
+	if ok {
+		return counts, voters, nil
+	}
+
+	// Read before counting, so that a vote missing from the count refuses the load.
+	version, versionErr := u.pollCountsRepo.Version(ctx, pollID)
+
+	counts, voters, err = u.pollVotesRepo.Count(ctx, pollID)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	if versionErr != nil {
+		log.ZWarn(ctx, "poll count version read failed", versionErr, "pollID", pollID)
+		return counts, voters, nil
+	}
+	if loadErr := u.pollCountsRepo.Load(ctx, pollID, version, counts, voters); loadErr != nil {
+		log.ZWarn(ctx, "poll count load failed", loadErr, "pollID", pollID)
+	}
+
+	return counts, voters, nil
+}
@@ -0,0 +1,68 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	discoveryPolls "github.com/1nterdigital/aka-im-discover/internal/repository/discover/polls"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+type DiscoverPollsUseCase = SectionUseCase[
+	model.DiscoverPolls, *model.DiscoverPolls,
+	domain.DiscoverPollsAddReq, domain.DiscoverPollsEditReq,
+]
+
+func NewDiscoverPollsUseCase(
+	discoverPollsRepo discoveryPolls.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverPollsUseCase {
+	return NewSectionUseCase(newPoll, pollUpdates, discoverPollsRepo, statusLogsRepo, revisionsRepo)
+}
+
+func newPoll(req *domain.DiscoverPollsAddReq) (*model.DiscoverPolls, error) {
+	base, err := newContentBase(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	options := make(model.PollOptions, 0, len(req.Options))
+	for i, label := range req.Options {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			return nil, errs.ErrArgs.WrapMsg("poll options must not be blank")
+		}
+		options = append(options, model.PollOption{ID: int64(i + 1), Label: label})
+	}
+
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverPolls{
+		ContentBase: base,
+		Options:     options,
+		MultiChoice: req.MultiChoice,
+		OpensAt:     req.OpensAt,
+		ClosesAt:    req.ClosesAt,
+	}, nil
+}
+
+func pollUpdates(
+	req *domain.DiscoverPollsEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	if req.OpensAt != nil {
+		updates["opens_at"] = req.OpensAt
+	}
+	if req.ClosesAt != nil {
+		updates["closes_at"] = req.ClosesAt
+	}
+
+	return req.ID, req.UpdatedBy, updates, nil
+}
@@ -0,0 +1,53 @@
+package usecase
+
+import (
+	"strings"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	discoveryQuickLinks "github.com/1nterdigital/aka-im-discover/internal/repository/discover/quicklinks"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+)
+
+type DiscoverQuickLinksUseCase = SectionUseCase[
+	model.DiscoverQuickLinks, *model.DiscoverQuickLinks,
+	domain.DiscoverQuickLinksAddReq, domain.DiscoverQuickLinksEditReq,
+]
+
+func NewDiscoverQuickLinksUseCase(
+	discoverQuickLinksRepo discoveryQuickLinks.Repository,
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *DiscoverQuickLinksUseCase {
+	return NewSectionUseCase(newQuickLink, quickLinkUpdates, discoverQuickLinksRepo, statusLogsRepo, revisionsRepo)
+}
+
+func newQuickLink(req *domain.DiscoverQuickLinksAddReq) (*model.DiscoverQuickLinks, error) {
+	base, err := newContentBase(req.Content())
+	if err != nil {
+		return nil, err
+	}
+
+	base.CreatedBy = req.CreatedBy
+	return &model.DiscoverQuickLinks{
+		ContentBase: base,
+		GroupName:   strings.TrimSpace(req.Group),
+	}, nil
+}
+
+func quickLinkUpdates(
+	req *domain.DiscoverQuickLinksEditReq,
+) (id int64, updatedBy string, updates map[string]interface{}, err error) {
+	updates, err = contentUpdates(req.Content())
+	if err != nil {
+		return 0, "", nil, err
+	}
+
+	// An empty group moves the link to the ungrouped links.
+	if req.Group != nil {
+		updates["group_name"] = strings.TrimSpace(*req.Group)
+	}
+
+	return req.ID, req.UpdatedBy, updates, nil
+}
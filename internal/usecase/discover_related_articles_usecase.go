@@ -0,0 +1,176 @@
+package usecase
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"unicode"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/articles"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/relatedcache"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// Weights of the signals an article shares with its neighbors.
+const (
+	relatedTagWeight      = 2.0
+	relatedCategoryWeight = 1.0
+	relatedTitleWeight    = 3.0
+)
+
+const (
+	// relatedCached is the length of a cached neighbor list. It is longer than what
+	// is shown so that targeting can drop some neighbors and still leave enough.
+	relatedCached = 20
+	// minTitleTermLength leaves short words such as articles and prepositions out
+	// of the title similarity.
+	minTitleTermLength = 3
+)
+
+type DiscoverRelatedArticlesUseCase struct {
+	articlesRepo articles.Repository
+	relatedRepo  relatedcache.Repository
+}
+
+func NewDiscoverRelatedArticlesUseCase(
+	articlesRepo articles.Repository, relatedRepo relatedcache.Repository,
+) *DiscoverRelatedArticlesUseCase {
+	return &DiscoverRelatedArticlesUseCase{
+		articlesRepo: articlesRepo,
+		relatedRepo:  relatedRepo,
+	}
+}
+
+// Related returns the IDs of the live articles closest to article, best first. The
+// lists are cached, so they may name articles that are not visible anymore.
+func (u *DiscoverRelatedArticlesUseCase) Related(
+	ctx context.Context, article *model.DiscoverArticles,
+) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("articleID", article.ID))
+
+	resp, ok, err := u.relatedRepo.Find(ctx, article.ID)
+	if err != nil {
+		log.ZWarn(ctx, "related articles cache read failed", err, "articleID", article.ID)
+	}
+	if ok {
+		return resp, nil
+	}
+
+	candidates, _, err := u.articlesRepo.Find(ctx, &domain.ContentFindReq{
+		Page:       1,
+		Limit:      maxAudienceScan,
+		SortBy:     "position",
+		Order:      "ASC",
+		Schedule:   domain.ScheduleLive,
+		Status:     domain.StatusPublished,
+		ExcludeIDs: []int64{article.ID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp = relatedArticles(article, candidates, relatedCached)
+
+	if saveErr := u.relatedRepo.Save(ctx, article.ID, resp); saveErr != nil {
+		log.ZWarn(ctx, "related articles cache write failed", saveErr, "articleID", article.ID)
+	}
+	return resp, nil
+}
+
+// relatedArticles scores candidates by the tags they share with article, its category
+// and the overlap of the title terms, and returns the IDs of the best size ones. Ties
+// keep the order of candidates.
+func relatedArticles(article *model.DiscoverArticles, candidates []*model.DiscoverArticles, size int) []int64 {
+	type scored struct {
+		id    int64
+		score float64
+	}
+
+	terms := titleTerms(article.Title)
+	ranked := make([]scored, 0, len(candidates))
+	for _, candidate := range candidates {
+		score := relatedTagWeight * float64(sharedTags(article.Tags, candidate.Tags))
+		if article.CategoryID != nil && candidate.CategoryID != nil && *article.CategoryID == *candidate.CategoryID {
+			score += relatedCategoryWeight
+		}
+		score += relatedTitleWeight * jaccard(terms, titleTerms(candidate.Title))
+
+		if score > 0 {
+			ranked = append(ranked, scored{id: candidate.ID, score: score})
+		}
+	}
+
+	slices.SortStableFunc(ranked, func(a, b scored) int {
+		switch {
+		case a.score > b.score:
+			return -1
+		case a.score < b.score:
+			return 1
+		default:
+			return 0
+		}
+	})
+
+	resp := make([]int64, 0, min(size, len(ranked)))
+	for _, item := range ranked[:min(size, len(ranked))] {
+		resp = append(resp, item.id)
+	}
+	return resp
+}
+
+func sharedTags(a, b model.Tags) int {
+	count := 0
+	for _, tag := range a {
+		if slices.Contains(b, tag) {
+			count++
+		}
+	}
+	return count
+}
+
+// titleTerms splits a title into its lowercased words, leaving out the short ones.
+func titleTerms(title string) map[string]struct{} {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	terms := make(map[string]struct{}, len(words))
+	for _, word := range words {
+		if len([]rune(word)) >= minTitleTermLength {
+			terms[word] = struct{}{}
+		}
+	}
+	return terms
+}
+
+// jaccard returns the share of the terms of a and b that both have.
+func jaccard(a, b map[string]struct{}) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for term := range a {
+		if _, ok := b[term]; ok {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
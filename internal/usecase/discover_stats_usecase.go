@@ -0,0 +1,351 @@
+package usecase
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/itemstats"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/trackingevents"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/viewers"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverStatsUseCase struct {
+	statsRepo    itemstats.Repository
+	viewersRepo  viewers.Repository
+	trackingRepo trackingevents.Repository
+}
+
+func NewDiscoverStatsUseCase(
+	statsRepo itemstats.Repository, viewersRepo viewers.Repository, trackingRepo trackingevents.Repository,
+) *DiscoverStatsUseCase {
+	return &DiscoverStatsUseCase{
+		statsRepo:    statsRepo,
+		viewersRepo:  viewersRepo,
+		trackingRepo: trackingRepo,
+	}
+}
+
+// Rollup recomputes the daily statistics of the given UTC day from the tracking events
+// and persists the approximate viewers counted in Redis.
+func (u *DiscoverStatsUseCase) Rollup(ctx context.Context, day time.Time) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.String("day", day.UTC().Format(domain.StatsDayLayout)))
+
+	err = u.statsRepo.Rollup(ctx, day)
+	if err != nil {
+		return err
+	}
+
+	items, err := u.viewersRepo.Items(ctx, day)
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return nil
+	}
+
+	counts, err := u.viewersRepo.Counts(ctx, day, items)
+	if err != nil {
+		return err
+	}
+
+	rows := make([]*model.DiscoverItemStatsDaily, 0, len(items))
+	for i, item := range items {
+		rows = append(rows, &model.DiscoverItemStatsDaily{
+			ItemType: item.Type,
+			ItemID:   item.ID,
+			Viewers:  counts[i],
+		})
+	}
+
+	return u.statsRepo.SaveViewers(ctx, day, rows)
+}
+
+// Find lists the daily statistics of the items, latest day first. The viewers of the days
+// still counted in Redis are read live, as the persisted ones lag behind the rollup.
+func (u *DiscoverStatsUseCase) Find(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+) (resp []domain.DiscoverItemStats, total int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", req.ItemType),
+		attribute.Int64("itemID", req.ItemID),
+	)
+
+	rows, total, err := u.statsRepo.Find(ctx, req)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	err = u.liveViewers(ctx, rows)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp = make([]domain.DiscoverItemStats, 0, len(rows))
+	for _, row := range rows {
+		stats := itemStats(row)
+		stats.Day = row.Day.Format(domain.StatsDayLayout)
+		resp = append(resp, stats)
+	}
+
+	return resp, total, nil
+}
+
+// liveViewers raises the viewers of the rows of recent days to their Redis counts.
+func (u *DiscoverStatsUseCase) liveViewers(ctx context.Context, rows []*model.DiscoverItemStatsDaily) (err error) {
+	byDay := map[string][]*model.DiscoverItemStatsDaily{}
+	for _, row := range rows {
+		if viewersRetained(row.Day) {
+			day := row.Day.Format(domain.StatsDayLayout)
+			byDay[day] = append(byDay[day], row)
+		}
+	}
+
+	for day, dayRows := range byDay {
+		date, parseErr := time.Parse(domain.StatsDayLayout, day)
+		if parseErr != nil {
+			continue
+		}
+
+		items := make([]viewers.Item, 0, len(dayRows))
+		for _, row := range dayRows {
+			items = append(items, viewers.Item{Type: row.ItemType, ID: row.ItemID})
+		}
+
+		var counts []int64
+		counts, err = u.viewersRepo.Counts(ctx, date, items)
+		if err != nil {
+			return err
+		}
+		for i, row := range dayRows {
+			row.Viewers = max(row.Viewers, counts[i])
+		}
+	}
+
+	return nil
+}
+
+// Top ranks the items by a metric over the requested days. Unique users and viewers
+// count a user once over the whole range; viewers are only known, and can only be ranked
+// by, while Redis keeps the counters of every day of the range.
+func (u *DiscoverStatsUseCase) Top(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+) (resp []domain.DiscoverItemStats, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", req.ItemType),
+		attribute.Int("top", req.Top),
+		attribute.String("by", req.By),
+	)
+
+	retained := viewersRetained(req.From)
+
+	var rows []*model.DiscoverItemStatsDaily
+	if req.By == domain.StatsByViewers {
+		if !retained {
+			return nil, errs.ErrArgs.WrapMsg(
+				"viewers can only be ranked over the last " + strconv.Itoa(domain.ViewersRetentionDays) + " days")
+		}
+
+		rows, err = u.topViewers(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		err = u.statsRepo.Totals(ctx, req, rows)
+	} else {
+		rows, err = u.statsRepo.Top(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		if retained {
+			err = u.rangeViewers(ctx, req, rows)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	resp = make([]domain.DiscoverItemStats, 0, len(rows))
+	for _, row := range rows {
+		resp = append(resp, itemStats(row))
+	}
+
+	return resp, nil
+}
+
+// topViewers ranks the items viewed over the range by their distinct viewers.
+func (u *DiscoverStatsUseCase) topViewers(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq,
+) (resp []*model.DiscoverItemStatsDaily, err error) {
+	seen := map[viewers.Item]struct{}{}
+	var items []viewers.Item
+	for day := req.From; !day.After(req.To); day = day.AddDate(0, 0, 1) {
+		var dayItems []viewers.Item
+		dayItems, err = u.viewersRepo.Items(ctx, day)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range dayItems {
+			if (req.ItemType != "" && item.Type != req.ItemType) || (req.ItemID != 0 && item.ID != req.ItemID) {
+				continue
+			}
+			if _, ok := seen[item]; ok {
+				continue
+			}
+			seen[item] = struct{}{}
+			items = append(items, item)
+		}
+	}
+
+	counts, err := u.viewersRepo.RangeCounts(ctx, req.From, req.To, items)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = make([]*model.DiscoverItemStatsDaily, 0, len(items))
+	for i, item := range items {
+		resp = append(resp, &model.DiscoverItemStatsDaily{ItemType: item.Type, ItemID: item.ID, Viewers: counts[i]})
+	}
+	slices.SortFunc(resp, func(a, b *model.DiscoverItemStatsDaily) int {
+		return cmp.Or(
+			cmp.Compare(b.Viewers, a.Viewers),
+			cmp.Compare(a.ItemType, b.ItemType),
+			cmp.Compare(a.ItemID, b.ItemID),
+		)
+	})
+
+	return resp[:min(len(resp), req.Top)], nil
+}
+
+// rangeViewers sets the distinct viewers of the rows over the range.
+func (u *DiscoverStatsUseCase) rangeViewers(
+	ctx context.Context, req *domain.DiscoverItemStatsFindReq, rows []*model.DiscoverItemStatsDaily,
+) error {
+	items := make([]viewers.Item, 0, len(rows))
+	for _, row := range rows {
+		items = append(items, viewers.Item{Type: row.ItemType, ID: row.ItemID})
+	}
+
+	counts, err := u.viewersRepo.RangeCounts(ctx, req.From, req.To, items)
+	if err != nil {
+		return err
+	}
+	for i, row := range rows {
+		row.Viewers = counts[i]
+	}
+
+	return nil
+}
+
+// VariantStats reports the impressions and clicks of each variant of the running
+// experiment of a carousel, counted from the tracking events since it started.
+func (u *DiscoverStatsUseCase) VariantStats(
+	ctx context.Context, carousel *model.DiscoverCarousels,
+) (resp []domain.DiscoverCarouselVariantStats, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("carouselID", carousel.ID))
+
+	experiment := carousel.Experiment
+	if experiment == nil || len(experiment.Variants) == 0 {
+		return []domain.DiscoverCarouselVariantStats{}, nil
+	}
+
+	counts, err := u.trackingRepo.VariantCounts(ctx, domain.ItemTypeCarousel, carousel.ID, experiment.StartedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	resp = make([]domain.DiscoverCarouselVariantStats, 0, len(experiment.Variants))
+	byKey := make(map[string]*domain.DiscoverCarouselVariantStats, len(experiment.Variants))
+	for _, variant := range experiment.Variants {
+		resp = append(resp, domain.DiscoverCarouselVariantStats{Key: variant.Key, Weight: variant.Weight})
+	}
+	for i := range resp {
+		byKey[resp[i].Key] = &resp[i]
+	}
+
+	for _, count := range counts {
+		stats, ok := byKey[count.Variant]
+		if !ok {
+			continue
+		}
+		switch count.Kind {
+		case domain.TrackingImpression:
+			stats.Impressions += count.Count
+		case domain.TrackingClick:
+			stats.Clicks += count.Count
+		}
+	}
+	for i := range resp {
+		resp[i].CTR = domain.ClickThroughRate(resp[i].Impressions, resp[i].Clicks)
+	}
+
+	return resp, nil
+}
+
+func itemStats(row *model.DiscoverItemStatsDaily) domain.DiscoverItemStats {
+	return domain.DiscoverItemStats{
+		ItemType:    row.ItemType,
+		ItemID:      row.ItemID,
+		Impressions: row.Impressions,
+		Clicks:      row.Clicks,
+		UniqueUsers: row.UniqueUsers,
+		CTR:         domain.ClickThroughRate(row.Impressions, row.Clicks),
+		Viewers:     row.Viewers,
+	}
+}
+
+// viewersRetained reports whether Redis still keeps the viewer counters of the UTC day.
+func viewersRetained(day time.Time) bool {
+	oldest := time.Now().UTC().AddDate(0, 0, 1-domain.ViewersRetentionDays).Format(domain.StatsDayLayout)
+	return day.UTC().Format(domain.StatsDayLayout) >= oldest
+}
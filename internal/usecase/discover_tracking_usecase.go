@@ -0,0 +1,181 @@
+package usecase
+
+import (
+	"context"
+	"errors"
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/trackingevents"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/viewers"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-discover/pkg/tools/batcher"
+	"github.com/1nterdigital/aka-im-tools/log"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// TrackingOptions tunes the batcher between the tracking endpoint and MySQL; zero
+// values keep the batcher defaults.
+type TrackingOptions struct {
+	// BatchSize is the number of events that triggers a flush.
+	BatchSize int
+	// Interval flushes the pending events even when the batch is not full.
+	Interval time.Duration
+	// Workers is the number of shards writing to MySQL in parallel.
+	Workers int
+	// Buffer is the number of events waiting to be batched; requests that do not fit
+	// are refused.
+	Buffer int
+	// LookbackDays is the number of days before the current one the stats rollup still
+	// rebuilds; events reported as older would never be counted.
+	LookbackDays int
+}
+
+type DiscoverTrackingUseCase struct {
+	trackingRepo trackingevents.Repository
+	viewersRepo  viewers.Repository
+	batcher      *batcher.Batcher[model.DiscoverTrackingEvents]
+	lookbackDays int
+}
+
+// NewDiscoverTrackingUseCase starts the batcher; Close flushes it.
+func NewDiscoverTrackingUseCase(
+	trackingRepo trackingevents.Repository, viewersRepo viewers.Repository, opts TrackingOptions,
+) (*DiscoverTrackingUseCase, error) {
+	var batcherOpts []batcher.Option
+	if opts.BatchSize > 0 {
+		batcherOpts = append(batcherOpts, batcher.WithSize(opts.BatchSize))
+	}
+	if opts.Interval > 0 {
+		batcherOpts = append(batcherOpts, batcher.WithInterval(opts.Interval))
+	}
+	if opts.Workers > 0 {
+		batcherOpts = append(batcherOpts, batcher.WithWorker(opts.Workers))
+	}
+	if opts.Buffer > 0 {
+		batcherOpts = append(batcherOpts, batcher.WithDataBuffer(opts.Buffer))
+	}
+
+	u := &DiscoverTrackingUseCase{
+		trackingRepo: trackingRepo,
+		viewersRepo:  viewersRepo,
+		lookbackDays: max(opts.LookbackDays, 0),
+		batcher:      batcher.New[model.DiscoverTrackingEvents](batcherOpts...),
+	}
+
+	// Events of an item always land on the same worker.
+	workers := uint32(u.batcher.Worker())
+	u.batcher.Key = func(event *model.DiscoverTrackingEvents) string {
+		return strconv.FormatInt(event.ItemID, 10)
+	}
+	u.batcher.Sharding = func(key string) int {
+		hash := fnv.New32a()
+		_, _ = hash.Write([]byte(key))
+		return int(hash.Sum32() % workers)
+	}
+	u.batcher.Do = u.insert
+
+	err := u.batcher.Start()
+	if err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// Track queues the events of req for insertion and counts the user as a viewer of their
+// items. The whole request is refused with ErrTrackingBusy when the batcher has no room
+// left for it.
+func (u *DiscoverTrackingUseCase) Track(ctx context.Context, req *domain.DiscoverTrackingReq) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("events", len(req.Events)),
+		attribute.String("userID", req.UserID),
+	)
+
+	now := time.Now()
+	// The start of the oldest UTC day the stats rollup still rebuilds.
+	oldest := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -u.lookbackDays)
+	events := make([]*model.DiscoverTrackingEvents, 0, len(req.Events))
+	for _, event := range req.Events {
+		occurredAt := now
+		if event.OccurredAt != nil && event.OccurredAt.Before(now) && !event.OccurredAt.Before(oldest) {
+			occurredAt = *event.OccurredAt
+		}
+
+		events = append(events, &model.DiscoverTrackingEvents{
+			ItemType:    event.ItemType,
+			ItemID:      event.ItemID,
+			Kind:        event.Kind,
+			Variant:     event.Variant,
+			UserID:      req.UserID,
+			Platform:    req.Platform,
+			OperationID: req.OperationID,
+			OccurredAt:  occurredAt,
+			CreatedAt:   now,
+		})
+	}
+
+	err = u.batcher.TryPut(events)
+	if errors.Is(err, batcher.ErrFull) {
+		return eerrs.ErrTrackingBusy.WrapMsg("too many tracking events, retry later")
+	}
+	if err != nil {
+		return err
+	}
+
+	// The events are queued already; a lost viewer only makes the approximation coarser.
+	viewErr := u.viewersRepo.Add(ctx, req.UserID, views(events))
+	if viewErr != nil {
+		log.ZWarn(ctx, "failed to count viewers", viewErr, "userID", req.UserID)
+	}
+	return nil
+}
+
+// views lists the distinct items of events by UTC day.
+func views(events []*model.DiscoverTrackingEvents) []viewers.View {
+	seen := make(map[viewers.View]struct{}, len(events))
+	resp := make([]viewers.View, 0, len(events))
+	for _, event := range events {
+		view := viewers.View{
+			Item: viewers.Item{Type: event.ItemType, ID: event.ItemID},
+			Day:  event.OccurredAt.UTC().Truncate(24 * time.Hour),
+		}
+		if _, ok := seen[view]; ok {
+			continue
+		}
+		seen[view] = struct{}{}
+		resp = append(resp, view)
+	}
+	return resp
+}
+
+// Close stops accepting events and waits for the queued ones to be inserted.
+func (u *DiscoverTrackingUseCase) Close() {
+	u.batcher.Close()
+}
+
+// insert writes the events of one item batched together.
+func (u *DiscoverTrackingUseCase) insert(
+	ctx context.Context, _ int, msg *batcher.Msg[model.DiscoverTrackingEvents],
+) {
+	err := u.trackingRepo.CreateBatch(ctx, msg.Val())
+	if err != nil {
+		log.ZError(ctx, "tracking events insert failed", err, "itemID", msg.Key(), "count", len(msg.Val()))
+	}
+}
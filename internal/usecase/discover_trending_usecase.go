@@ -0,0 +1,113 @@
+package usecase
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/trending"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+type DiscoverTrendingUseCase struct {
+	trendingRepo trending.Repository
+}
+
+func NewDiscoverTrendingUseCase(trendingRepo trending.Repository) *DiscoverTrendingUseCase {
+	return &DiscoverTrendingUseCase{
+		trendingRepo: trendingRepo,
+	}
+}
+
+// RecordOpen counts an open of an article towards its trending score.
+func (u *DiscoverTrendingUseCase) RecordOpen(ctx context.Context, articleID int64) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(attribute.Int64("articleID", articleID))
+
+	return u.trendingRepo.Open(ctx, articleID, time.Now())
+}
+
+// Refresh recomputes the ranking of a window. Each open weighs half as much every
+// halfLife, taking the middle of its hour as its time.
+func (u *DiscoverTrendingUseCase) Refresh(
+	ctx context.Context, windowHours int, halfLife time.Duration,
+) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("windowHours", windowHours),
+		attribute.String("halfLife", halfLife.String()),
+	)
+
+	now := time.Now()
+	current := now.Truncate(time.Hour)
+	hours := make([]time.Time, windowHours)
+	for i := range hours {
+		hours[i] = current.Add(-time.Duration(i) * time.Hour)
+	}
+
+	opens, err := u.trendingRepo.Opens(ctx, hours)
+	if err != nil {
+		return err
+	}
+
+	scores := map[int64]float64{}
+	for i, counts := range opens {
+		age := now.Sub(hours[i].Add(time.Hour / 2))
+		if age < 0 {
+			age = 0
+		}
+		weight := math.Exp2(-age.Hours() / halfLife.Hours())
+
+		for articleID, count := range counts {
+			scores[articleID] += count * weight
+		}
+	}
+
+	return u.trendingRepo.SaveRanking(ctx, windowHours, scores)
+}
+
+// Ranking returns up to size article IDs trending in a window, best first. The IDs
+// may name articles that are not visible anymore.
+func (u *DiscoverTrendingUseCase) Ranking(
+	ctx context.Context, windowHours int, size int64,
+) (resp []int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.Int("windowHours", windowHours),
+		attribute.Int64("size", size),
+	)
+
+	return u.trendingRepo.Ranking(ctx, windowHours, size)
+}
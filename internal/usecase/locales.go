@@ -0,0 +1,30 @@
+package usecase
+
+import (
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+// toModelLocales validates the language tags sent by admins and stores them normalized.
+// A nil map means "unchanged" on edit, an empty one clears every variant.
+func toModelLocales(locales domain.Locales) (model.Locales, error) {
+	if locales == nil {
+		return nil, nil
+	}
+
+	resp := make(model.Locales, len(locales))
+	for tag, content := range locales {
+		locale := domain.NormalizeLocale(tag)
+		if !domain.ValidLocale(locale) {
+			return nil, errs.ErrArgs.WrapMsg("invalid locale " + tag)
+		}
+		resp[locale] = model.Localized{
+			Title:    content.Title,
+			ImageURL: content.ImageURL,
+			LinkURL:  content.LinkURL,
+		}
+	}
+
+	return resp, nil
+}
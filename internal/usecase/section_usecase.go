@@ -0,0 +1,501 @@
+package usecase
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/revisions"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/section"
+	"github.com/1nterdigital/aka-im-discover/internal/repository/discover/statuslogs"
+	"github.com/1nterdigital/aka-im-discover/pkg/eerrs"
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/tracer"
+)
+
+// SectionUseCase serves one discover section: T is its model, A its add request
+// and E its edit request.
+type SectionUseCase[T any, P model.ContentPtr[T], A any, E any] struct {
+	fromAdd        func(req *A) (P, error)
+	toUpdates      func(req *E) (id int64, updatedBy string, updates map[string]interface{}, err error)
+	repo           section.Repository[P]
+	statusLogsRepo statuslogs.Repository
+	revisionsRepo  revisions.Repository
+}
+
+// NewSectionUseCase registers a section: fromAdd builds a new item from an add request
+// and toUpdates returns the item an edit request changes, its editor and the changed columns.
+func NewSectionUseCase[T any, P model.ContentPtr[T], A any, E any](
+	fromAdd func(req *A) (P, error),
+	toUpdates func(req *E) (id int64, updatedBy string, updates map[string]interface{}, err error),
+	repo section.Repository[P],
+	statusLogsRepo statuslogs.Repository,
+	revisionsRepo revisions.Repository,
+) *SectionUseCase[T, P, A, E] {
+	return &SectionUseCase[T, P, A, E]{
+		fromAdd:        fromAdd,
+		toUpdates:      toUpdates,
+		repo:           repo,
+		statusLogsRepo: statusLogsRepo,
+		revisionsRepo:  revisionsRepo,
+	}
+}
+
+// ItemType names the section in routes, history rows and errors.
+func (u *SectionUseCase[T, P, A, E]) ItemType() string {
+	return u.repo.ItemType()
+}
+
+// FilterParams lists the section-specific query params its find accepts.
+func (u *SectionUseCase[T, P, A, E]) FilterParams() []string {
+	return u.repo.FilterParams()
+}
+
+// Create stores a new draft; createdBy is used when the request does not name its author.
+func (u *SectionUseCase[T, P, A, E]) Create(
+	ctx context.Context, req *A, createdBy string,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	item, err := u.fromAdd(req)
+	if err != nil {
+		return nil, err
+	}
+
+	base := item.Base()
+	if !domain.ValidSchedule(base.PublishAt, base.ExpireAt) {
+		return nil, errs.ErrArgs.WrapMsg("expireAt must be after publishAt")
+	}
+	if base.CreatedBy == "" {
+		base.CreatedBy = createdBy
+	}
+	base.Status = domain.StatusDraft
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.String("title", base.Title),
+		attribute.String("createdBy", base.CreatedBy),
+	)
+
+	err = u.repo.Create(ctx, item)
+	if err != nil {
+		return nil, err
+	}
+
+	return item, nil
+}
+
+func (u *SectionUseCase[T, P, A, E]) Find(
+	ctx context.Context, req *domain.ContentFindReq,
+) (resp []P, total int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", req.ID),
+		attribute.String("title", req.Title),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+		attribute.String("schedule", req.Schedule),
+	)
+
+	if req.Audience == nil {
+		resp, total, err = u.repo.Find(ctx, req)
+		return resp, total, err
+	}
+
+	// The repository applies the rules SQL can evaluate; the app version rules are checked
+	// here, so every candidate is counted in batches and only the requested page is kept.
+	start := int64(req.Page-1) * int64(req.Limit)
+	end := start + int64(req.Limit)
+	resp = []P{}
+
+	scan := *req
+	scan.Page, scan.Limit = 1, audienceScanBatch
+	for {
+		var (
+			items []P
+			count int64
+		)
+		items, count, err = u.repo.Find(ctx, &scan)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		for _, item := range items {
+			if !audienceMatches(item.Base().Targeting, req.Audience) {
+				continue
+			}
+			if total >= start && total < end {
+				resp = append(resp, item)
+			}
+			total++
+		}
+
+		if int64(scan.Page)*int64(scan.Limit) >= count || len(items) == 0 {
+			return resp, total, nil
+		}
+		scan.Page++
+	}
+}
+
+// Get returns a single item, limited to the given schedule and status when they are set
+// and hidden when its targeting excludes audience.
+func (u *SectionUseCase[T, P, A, E]) Get(
+	ctx context.Context, id int64, schedule, status string, audience *domain.Audience,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", id),
+		attribute.String("schedule", schedule),
+		attribute.String("status", status),
+	)
+
+	resp, err = u.repo.Get(ctx, id, schedule, status)
+	if err != nil {
+		return nil, err
+	}
+
+	if !audienceMatches(resp.Base().Targeting, audience) {
+		return nil, eerrs.ErrItemNotFound.WrapMsg(u.ItemType() + " not found")
+	}
+
+	return resp, nil
+}
+
+func (u *SectionUseCase[T, P, A, E]) Delete(ctx context.Context, id int64, deletedBy string) (err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", id),
+		attribute.String("deletedBy", deletedBy),
+	)
+
+	err = u.repo.Delete(ctx, id, deletedBy)
+	return err
+}
+
+// Edit applies an edit request; updatedBy is used when the request does not name its editor.
+func (u *SectionUseCase[T, P, A, E]) Edit(
+	ctx context.Context, req *E, updatedBy string,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	id, editor, updates, err := u.toUpdates(req)
+	if err != nil {
+		return nil, err
+	}
+	if editor == "" {
+		editor = updatedBy
+	}
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", id),
+		attribute.String("updatedBy", editor),
+	)
+
+	resp, err = u.repo.Edit(ctx, id, editor, updates)
+	return resp, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) Transition(
+	ctx context.Context, req *domain.DiscoverStatusReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", req.ID),
+		attribute.String("action", req.Action),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	resp, err = u.repo.Transition(ctx, req)
+	return resp, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) FindStatusLogs(
+	ctx context.Context, id int64,
+) (resp []*model.DiscoverStatusLogs, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", id),
+	)
+
+	resp, err = u.statusLogsRepo.Find(ctx, u.ItemType(), id)
+	return resp, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) History(
+	ctx context.Context, id int64,
+) (resp []*model.DiscoverRevisions, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", id),
+	)
+
+	resp, err = u.revisionsRepo.Find(ctx, u.ItemType(), id)
+	return resp, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) Rollback(
+	ctx context.Context, req *domain.DiscoverRollbackReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", req.ID),
+		attribute.Int("revision", req.Revision),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	resp, err = u.repo.Rollback(ctx, req)
+	return resp, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) FindTrash(
+	ctx context.Context, req *domain.DiscoverTrashFindReq,
+) (resp []P, total int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.String("title", req.Title),
+		attribute.Int("page", int(req.Page)),
+		attribute.Int("limit", int(req.Limit)),
+	)
+
+	resp, total, err = u.repo.FindTrash(ctx, req)
+	return resp, total, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) Restore(
+	ctx context.Context, req *domain.DiscoverRestoreReq,
+) (resp P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int64("id", req.ID),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	resp, err = u.repo.Restore(ctx, req)
+	return resp, err
+}
+
+// Purge permanently removes items that have been in the trash since before deletedBefore.
+func (u *SectionUseCase[T, P, A, E]) Purge(ctx context.Context, deletedBefore time.Time) (count int64, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.String("deletedBefore", deletedBefore.String()),
+	)
+
+	count, err = u.repo.Purge(ctx, deletedBefore)
+	return count, err
+}
+
+func (u *SectionUseCase[T, P, A, E]) Reorder(
+	ctx context.Context, req *domain.DiscoverReorderReq,
+) (resp []P, err error) {
+	ctx, span := otel.Tracer(domain.TracerLevelUsecase).
+		Start(ctx, tracer.GetFullFunctionPath())
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	span.SetAttributes(
+		attribute.String("itemType", u.ItemType()),
+		attribute.Int("count", len(req.IDs)),
+		attribute.String("operatedBy", req.OperatedBy),
+	)
+
+	resp, err = u.repo.Reorder(ctx, req)
+	return resp, err
+}
+
+// newContentBase converts the shared fields of an add request.
+func newContentBase(fields *domain.ContentFields) (base model.ContentBase, err error) {
+	base.Locales, err = toModelLocales(fields.Locales)
+	if err != nil {
+		return base, err
+	}
+
+	base.Targeting, err = toModelTargeting(fields.Targeting)
+	if err != nil {
+		return base, err
+	}
+
+	base.Title = fields.Title
+	base.ImageURL = fields.ImageURL
+	base.LinkURL = fields.LinkURL
+	base.Position = fields.Position
+	base.PublishAt = fields.PublishAt
+	base.ExpireAt = fields.ExpireAt
+
+	return base, nil
+}
+
+// contentUpdates returns the shared columns changed by an edit request; empty fields
+// are left unchanged and the publish window bounds are only removed when asked to.
+func contentUpdates(fields *domain.ContentFields) (map[string]interface{}, error) {
+	locales, err := toModelLocales(fields.Locales)
+	if err != nil {
+		return nil, err
+	}
+
+	targeting, err := toModelTargeting(fields.Targeting)
+	if err != nil {
+		return nil, err
+	}
+
+	updates := map[string]interface{}{}
+	if fields.Title != "" {
+		updates["title"] = fields.Title
+	}
+	if fields.ImageURL != "" {
+		updates["image_url"] = fields.ImageURL
+	}
+	if fields.LinkURL != "" {
+		updates["link_url"] = fields.LinkURL
+	}
+	if locales != nil {
+		updates["locales"] = locales
+	}
+	if targeting != nil {
+		updates["targeting"] = targeting
+	}
+	if fields.Position != nil {
+		updates["position"] = fields.Position
+	}
+	if fields.ClearPublishAt && fields.PublishAt != nil {
+		return nil, errs.ErrArgs.WrapMsg("publishAt cannot be set and cleared at once")
+	}
+	if fields.ClearExpireAt && fields.ExpireAt != nil {
+		return nil, errs.ErrArgs.WrapMsg("expireAt cannot be set and cleared at once")
+	}
+	if fields.PublishAt != nil || fields.ClearPublishAt {
+		updates["publish_at"] = fields.PublishAt
+	}
+	if fields.ExpireAt != nil || fields.ClearExpireAt {
+		updates["expire_at"] = fields.ExpireAt
+	}
+
+	return updates, nil
+}
@@ -0,0 +1,85 @@
+package usecase
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
+	model "github.com/1nterdigital/aka-im-discover/internal/model"
+	"github.com/1nterdigital/aka-im-tools/errs"
+)
+
+// maxAudienceScan bounds how many live items are loaded at once to be scored or
+// filtered in memory.
+const maxAudienceScan = 1000
+
+// audienceScanBatch is how many items an audience find loads per query while it pages
+// through the items the version rules still have to be checked for.
+const audienceScanBatch = 200
+
+// toModelTargeting validates targeting rules sent by admins. Platforms are stored
+// lowercased and regions uppercased so that matching is case-insensitive.
+func toModelTargeting(rules *domain.TargetingRules) (*model.Targeting, error) {
+	if rules == nil {
+		return nil, nil
+	}
+
+	if rules.MinAppVersion != "" && !domain.ValidVersion(rules.MinAppVersion) {
+		return nil, errs.ErrArgs.WrapMsg("invalid minAppVersion " + rules.MinAppVersion)
+	}
+	if rules.MaxAppVersion != "" && !domain.ValidVersion(rules.MaxAppVersion) {
+		return nil, errs.ErrArgs.WrapMsg("invalid maxAppVersion " + rules.MaxAppVersion)
+	}
+	if rules.MinAppVersion != "" && rules.MaxAppVersion != "" &&
+		domain.CompareVersions(rules.MinAppVersion, rules.MaxAppVersion) > 0 {
+		return nil, errs.ErrArgs.WrapMsg("minAppVersion must not be greater than maxAppVersion")
+	}
+
+	targeting := &model.Targeting{
+		MinAppVersion: rules.MinAppVersion,
+		MaxAppVersion: rules.MaxAppVersion,
+		UserTypes:     rules.UserTypes,
+	}
+	for _, platform := range rules.Platforms {
+		targeting.Platforms = append(targeting.Platforms, strings.ToLower(strings.TrimSpace(platform)))
+	}
+	for _, region := range rules.Regions {
+		targeting.Regions = append(targeting.Regions, strings.ToUpper(strings.TrimSpace(region)))
+	}
+
+	return targeting, nil
+}
+
+// audienceMatches reports whether an item with the given targeting is shown to audience.
+// Unknown audience attributes never satisfy a rule that constrains them.
+func audienceMatches(targeting *model.Targeting, audience *domain.Audience) bool {
+	if targeting.IsEmpty() || audience == nil {
+		return true
+	}
+
+	if len(targeting.Platforms) > 0 &&
+		!slices.Contains(targeting.Platforms, strings.ToLower(audience.Platform)) {
+		return false
+	}
+	if len(targeting.Regions) > 0 &&
+		!slices.Contains(targeting.Regions, strings.ToUpper(audience.Region)) {
+		return false
+	}
+	if len(targeting.UserTypes) > 0 && !slices.Contains(targeting.UserTypes, audience.UserType) {
+		return false
+	}
+
+	if targeting.MinAppVersion != "" || targeting.MaxAppVersion != "" {
+		if !domain.ValidVersion(audience.AppVersion) {
+			return false
+		}
+		if targeting.MinAppVersion != "" && domain.CompareVersions(audience.AppVersion, targeting.MinAppVersion) < 0 {
+			return false
+		}
+		if targeting.MaxAppVersion != "" && domain.CompareVersions(audience.AppVersion, targeting.MaxAppVersion) > 0 {
+			return false
+		}
+	}
+
+	return true
+}
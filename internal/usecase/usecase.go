@@ -2,30 +2,153 @@ package usecase
 
 import (
 	"github.com/1nterdigital/aka-im-discover/internal/repository"
+	"github.com/1nterdigital/aka-im-discover/pkg/common/imapi"
 )
 
 type UseCase struct {
-	Health            *HealthUseCase
-	DiscoverArticles  *DiscoverArticlesUseCase
-	DiscoverCarousels *DiscoverCarouselsUseCase
+	Health                 *HealthUseCase
+	DiscoverArticles       *DiscoverArticlesUseCase
+	DiscoverCarousels      *DiscoverCarouselsUseCase
+	DiscoverCategories     *DiscoverCategoriesUseCase
+	DiscoverAnnouncements  *DiscoverAnnouncementsUseCase
+	DiscoverDismissals     *DiscoverDismissalsUseCase
+	DiscoverQuickLinks     *DiscoverQuickLinksUseCase
+	DiscoverPageLayout     *DiscoverPageLayoutUseCase
+	DiscoverPolls          *DiscoverPollsUseCase
+	DiscoverPollVotes      *DiscoverPollVotesUseCase
+	DiscoverEvents         *DiscoverEventsUseCase
+	DiscoverEventRSVPs     *DiscoverEventRSVPsUseCase
+	DiscoverFeaturedGroups *DiscoverFeaturedGroupsUseCase
+	DiscoverIMGroups       *DiscoverIMGroupsUseCase
+	DiscoverCampaigns      *DiscoverCampaignsUseCase
+	DiscoverTrending       *DiscoverTrendingUseCase
+	DiscoverRelated        *DiscoverRelatedArticlesUseCase
+	DiscoverTracking       *DiscoverTrackingUseCase
+	DiscoverStats          *DiscoverStatsUseCase
 }
 
-func New(repo repository.Repository) (*UseCase, error) {
+func New(
+	repo repository.Repository, imApiCaller imapi.CallerInterface, tracking TrackingOptions,
+) (*UseCase, error) {
 	healthUsecase := NewHealthUseCase(
 		repo.Health(),
 	)
 
 	discoverArticlesUsecase := NewDiscoverArticlesUseCase(
 		repo.DiscoverArticles(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
 	)
 
 	discoverCarouselsUsecase := NewDiscoverCarouselsUseCase(
 		repo.DiscoverCarousels(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverCategoriesUsecase := NewDiscoverCategoriesUseCase(
+		repo.DiscoverCategories(),
+	)
+
+	discoverAnnouncementsUsecase := NewDiscoverAnnouncementsUseCase(
+		repo.DiscoverAnnouncements(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverDismissalsUsecase := NewDiscoverDismissalsUseCase(
+		repo.DiscoverDismissals(),
+	)
+
+	discoverQuickLinksUsecase := NewDiscoverQuickLinksUseCase(
+		repo.DiscoverQuickLinks(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverPageLayoutUsecase := NewDiscoverPageLayoutUseCase(
+		repo.DiscoverPageLayout(),
+	)
+
+	discoverPollsUsecase := NewDiscoverPollsUseCase(
+		repo.DiscoverPolls(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverPollVotesUsecase := NewDiscoverPollVotesUseCase(
+		repo.DiscoverPollVotes(),
+		repo.DiscoverPollCounts(),
+	)
+
+	discoverEventsUsecase := NewDiscoverEventsUseCase(
+		repo.DiscoverEvents(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverEventRSVPsUsecase := NewDiscoverEventRSVPsUseCase(
+		repo.DiscoverEventRSVPs(),
+	)
+
+	discoverFeaturedGroupsUsecase := NewDiscoverFeaturedGroupsUseCase(
+		repo.DiscoverFeaturedGroups(),
+		repo.DiscoverStatusLogs(),
+		repo.DiscoverRevisions(),
+	)
+
+	discoverIMGroupsUsecase := NewDiscoverIMGroupsUseCase(
+		imApiCaller,
+		repo.DiscoverGroupCache(),
+	)
+
+	discoverCampaignsUsecase := NewDiscoverCampaignsUseCase(
+		repo.DiscoverCampaigns(),
+	)
+
+	discoverTrendingUsecase := NewDiscoverTrendingUseCase(
+		repo.DiscoverTrending(),
+	)
+
+	discoverRelatedUsecase := NewDiscoverRelatedArticlesUseCase(
+		repo.DiscoverArticles(),
+		repo.DiscoverRelatedCache(),
+	)
+
+	discoverTrackingUsecase, err := NewDiscoverTrackingUseCase(
+		repo.DiscoverTrackingEvents(),
+		repo.DiscoverViewers(),
+		tracking,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	discoverStatsUsecase := NewDiscoverStatsUseCase(
+		repo.DiscoverItemStats(),
+		repo.DiscoverViewers(),
+		repo.DiscoverTrackingEvents(),
 	)
 
 	return &UseCase{
-		Health:            healthUsecase,
-		DiscoverArticles:  discoverArticlesUsecase,
-		DiscoverCarousels: discoverCarouselsUsecase,
+		Health:                 healthUsecase,
+		DiscoverArticles:       discoverArticlesUsecase,
+		DiscoverCarousels:      discoverCarouselsUsecase,
+		DiscoverCategories:     discoverCategoriesUsecase,
+		DiscoverAnnouncements:  discoverAnnouncementsUsecase,
+		DiscoverDismissals:     discoverDismissalsUsecase,
+		DiscoverQuickLinks:     discoverQuickLinksUsecase,
+		DiscoverPageLayout:     discoverPageLayoutUsecase,
+		DiscoverPolls:          discoverPollsUsecase,
+		DiscoverPollVotes:      discoverPollVotesUsecase,
+		DiscoverEvents:         discoverEventsUsecase,
+		DiscoverEventRSVPs:     discoverEventRSVPsUsecase,
+		DiscoverFeaturedGroups: discoverFeaturedGroupsUsecase,
+		DiscoverIMGroups:       discoverIMGroupsUsecase,
+		DiscoverCampaigns:      discoverCampaignsUsecase,
+		DiscoverTrending:       discoverTrendingUsecase,
+		DiscoverRelated:        discoverRelatedUsecase,
+		DiscoverTracking:       discoverTrackingUsecase,
+		DiscoverStats:          discoverStatsUsecase,
 	}, nil
 }
@@ -37,6 +37,32 @@ type API struct {
 		Ports        []int  `mapstructure:"ports"`
 		GrafanaURL   string `mapstructure:"grafanaURL"`
 	} `mapstructure:"prometheus"`
+	Trash struct {
+		RetentionDays        int `mapstructure:"retentionDays"`
+		PurgeIntervalMinutes int `mapstructure:"purgeIntervalMinutes"`
+	} `mapstructure:"trash"`
+	Locale struct {
+		Default string `mapstructure:"default"`
+	} `mapstructure:"locale"`
+	Trending struct {
+		WindowsHours           []int `mapstructure:"windowsHours"`
+		HalfLifeHours          int   `mapstructure:"halfLifeHours"`
+		RefreshIntervalMinutes int   `mapstructure:"refreshIntervalMinutes"`
+	} `mapstructure:"trending"`
+	Tracking struct {
+		BatchSize       int `mapstructure:"batchSize"`
+		FlushIntervalMs int `mapstructure:"flushIntervalMs"`
+		Workers         int `mapstructure:"workers"`
+		Buffer          int `mapstructure:"buffer"`
+	} `mapstructure:"tracking"`
+	Stats struct {
+		LookbackDays          int `mapstructure:"lookbackDays"`
+		RollupIntervalMinutes int `mapstructure:"rollupIntervalMinutes"`
+	} `mapstructure:"stats"`
+	Links struct {
+		AllowedSchemes []string `mapstructure:"allowedSchemes"`
+		AllowedHosts   []string `mapstructure:"allowedHosts"`
+	} `mapstructure:"links"`
 }
 
 type Discovery struct {
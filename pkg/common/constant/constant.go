@@ -21,6 +21,13 @@ const (
 
 const RpcCustomHeader = constant.RpcCustomHeader
 
+// Headers clients send so that discover content can be targeted.
+const (
+	HeaderPlatform   = "X-Platform"
+	HeaderAppVersion = "X-App-Version"
+	HeaderRegion     = "X-Region"
+)
+
 type ContextKey string
 
 const (
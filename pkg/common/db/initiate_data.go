@@ -5,6 +5,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	entity "github.com/1nterdigital/aka-im-discover/internal/model"
 )
 
@@ -60,30 +61,33 @@ func seedCarousels(gormDB *gorm.DB) error {
 
 	//nolint:lll // long URL
 	seeds := []entity.DiscoverCarousels{
-		{
+		{ContentBase: entity.ContentBase{
 			Title:     "Default Carousel 1",
 			ImageURL:  "https://media.istockphoto.com/id/473082752/id/foto/bunga-segar-dalam-es-krim-kerucut-masih-hidup.jpg?s=1024x1024&w=is&k=20&c=bRgYey2MywT7iJFE7W-FtMR9XIpxRd-PM0oqpzDFr2I=",
 			LinkURL:   "https://www.facebook.com",
 			Position:  intPtr(PositionDefault1),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Default Carousel 2",
 			ImageURL:  "https://media.istockphoto.com/id/1136116781/id/foto/telur-paskah-bunga-berwarna-warni-di-latar-belakang-biru-pastel-paskah-konsep-musim-semi-rata.jpg?s=2048x2048&w=is&k=20&c=3pPm6EMFmyeY3CyifRxyOH2DU2dViRe1R-50xNpH1-I=",
 			LinkURL:   "https://www.twitter.com",
 			Position:  intPtr(PositionDefault2),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Default Carousel 3",
 			ImageURL:  "https://media.istockphoto.com/id/1148668425/id/foto/celengan-merah-muda-kecil-terikat-di-atas-mobil-tua.jpg?s=2048x2048&w=is&k=20&c=5kPK-ReLQom11iZC-zPfxEd1a1dQ_2JY07J7zVnApKA=",
 			LinkURL:   "https://www.instagram.com",
 			Position:  intPtr(PositionDefault3),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
+		}},
 	}
 
 	if err := gormDB.Create(&seeds).Error; err != nil {
@@ -100,166 +104,186 @@ func seedArticles(gormDB *gorm.DB) error {
 	}
 
 	seeds := []entity.DiscoverArticles{
-		{
+		{ContentBase: entity.ContentBase{
 			Title:     "Facebook",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626269.png",
 			LinkURL:   "https://www.facebook.com",
 			Position:  intPtr(PositionFacebook),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Twitter",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626271.png",
 			LinkURL:   "https://www.twitter.com",
 			Position:  intPtr(PositionTwitter),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Instagram",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626270.png",
 			LinkURL:   "https://www.instagram.com",
 			Position:  intPtr(PositionInstagram),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "LinkedIn",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626273.png",
 			LinkURL:   "https://www.linkedin.com",
 			Position:  intPtr(PositionLinkedIn),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "YouTube",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626292.png",
 			LinkURL:   "https://www.youtube.com",
 			Position:  intPtr(PositionYouTube),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Reddit",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626300.png",
 			LinkURL:   "https://www.reddit.com",
 			Position:  intPtr(PositionReddit),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Pinterest",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626275.png",
 			LinkURL:   "https://www.pinterest.com",
 			Position:  intPtr(PositionPinterest),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "TikTok",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/3046/3046121.png",
 			LinkURL:   "https://www.tiktok.com",
 			Position:  intPtr(PositionTikTok),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Snapchat",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626276.png",
 			LinkURL:   "https://www.snapchat.com",
 			Position:  intPtr(PositionSnapchat),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "WhatsApp",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626279.png",
 			LinkURL:   "https://www.whatsapp.com",
 			Position:  intPtr(PositionWhatsApp),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Telegram",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626281.png",
 			LinkURL:   "https://telegram.org",
 			Position:  intPtr(PositionTelegram),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "GitHub",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/2175/2175377.png",
 			LinkURL:   "https://github.com",
 			Position:  intPtr(PositionGitHub),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Stack Overflow",
 			ImageURL:  "https://cdn-icons-png.freepik.com/256/2626/2626299.png",
 			LinkURL:   "https://stackoverflow.com",
 			Position:  intPtr(PositionStackOverflow),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Medium",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/2504/2504925.png",
 			LinkURL:   "https://medium.com",
 			Position:  intPtr(PositionMedium),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Netflix",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/2504/2504929.png",
 			LinkURL:   "https://www.netflix.com",
 			Position:  intPtr(PositionNetflix),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Amazon",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/14063/14063250.png",
 			LinkURL:   "https://www.amazon.com",
 			Position:  intPtr(PositionAmazon),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "eBay",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/14083/14083029.png",
 			LinkURL:   "https://www.ebay.com",
 			Position:  intPtr(PositionEbay),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Wikipedia",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/14064/14064552.png",
 			LinkURL:   "https://www.wikipedia.org",
 			Position:  intPtr(PositionWikipedia),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Google",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/14063/14063276.png",
 			LinkURL:   "https://www.google.com",
 			Position:  intPtr(PositionGoogle),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
-		{
+		}},
+		{ContentBase: entity.ContentBase{
 			Title:     "Yahoo",
 			ImageURL:  "https://cdn-icons-png.freepik.com/512/2175/2175361.png",
 			LinkURL:   "https://www.yahoo.com",
 			Position:  intPtr(PositionYahoo),
+			Status:    domain.StatusPublished,
 			CreatedBy: "system",
 			UpdatedBy: "system",
-		},
+		}},
 	}
 
 	if err := gormDB.Create(&seeds).Error; err != nil {
@@ -5,6 +5,7 @@ import (
 
 	"gorm.io/gorm"
 
+	"github.com/1nterdigital/aka-im-discover/internal/domain"
 	entity "github.com/1nterdigital/aka-im-discover/internal/model"
 )
 
@@ -12,15 +13,51 @@ func InitiateTable(gormDB *gorm.DB) error {
 	models := []interface{}{
 		&entity.DiscoverCarousels{},
 		&entity.DiscoverArticles{},
+		&entity.DiscoverStatusLogs{},
+		&entity.DiscoverRevisions{},
+		&entity.DiscoverCategories{},
+		&entity.DiscoverAnnouncements{},
+		&entity.DiscoverQuickLinks{},
+		&entity.DiscoverPageSections{},
+		&entity.DiscoverPolls{},
+		&entity.DiscoverPollVotes{},
+		&entity.DiscoverEvents{},
+		&entity.DiscoverEventRSVPs{},
+		&entity.DiscoverFeaturedGroups{},
+		&entity.DiscoverCampaigns{},
+		&entity.DiscoverTrackingEvents{},
+		&entity.DiscoverItemStatsDaily{},
 	}
+	// AutoMigrate also runs on existing tables so that newly added columns are created.
 	for _, model := range models {
-		if !gormDB.Migrator().HasTable(model) {
-			err := gormDB.AutoMigrate(model)
-			if err != nil {
-				return fmt.Errorf("failed to migrate database: %w", err)
-			}
+		err := gormDB.AutoMigrate(model)
+		if err != nil {
+			return fmt.Errorf("failed to migrate database: %w", err)
+		}
+	}
+
+	for _, model := range []interface{}{&entity.DiscoverCarousels{}, &entity.DiscoverArticles{}} {
+		if err := migrateIsActive(gormDB, model); err != nil {
+			return fmt.Errorf("failed to migrate database: %w", err)
 		}
 	}
 
 	return nil
 }
+
+// migrateIsActive carries items that were live through the old is_active flag
+// over to the published status, then drops the flag.
+func migrateIsActive(gormDB *gorm.DB, model interface{}) error {
+	if !gormDB.Migrator().HasColumn(model, "is_active") {
+		return nil
+	}
+
+	err := gormDB.Model(model).
+		Where("is_active = ? AND deleted_at IS NULL", true).
+		Update("status", domain.StatusPublished).Error
+	if err != nil {
+		return err
+	}
+
+	return gormDB.Migrator().DropColumn(model, "is_active")
+}
@@ -0,0 +1,77 @@
+package imapi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/1nterdigital/aka-im-tools/errs"
+	"github.com/1nterdigital/aka-im-tools/mcontext"
+)
+
+// IM API routes used by the discover service.
+const (
+	routeAdminToken    = "/auth/get_admin_token"
+	routeGetGroupsInfo = "/group/get_groups_info"
+)
+
+const requestTimeout = 10 * time.Second
+
+var client = &http.Client{Timeout: requestTimeout}
+
+type baseApiResponse[T any] struct {
+	ErrCode int    `json:"errCode"`
+	ErrMsg  string `json:"errMsg"`
+	ErrDlt  string `json:"errDlt"`
+	Data    *T     `json:"data"`
+}
+
+// call posts req to the IM API route and decodes the data of the response. The token
+// is left out of calls that obtain one.
+func call[Req, Resp any](ctx context.Context, apiURL, route, token string, req *Req) (*Resp, error) {
+	body, err := json.Marshal(req)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+route, bytes.NewReader(body))
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	request.Header.Set("Content-Type", "application/json")
+	request.Header.Set("operationID", mcontext.GetOperationID(ctx))
+	if token != "" {
+		request.Header.Set("token", token)
+	}
+
+	response, err := client.Do(request)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "im api call failed", "route", route)
+	}
+	defer response.Body.Close()
+
+	raw, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+	if response.StatusCode != http.StatusOK {
+		return nil, errs.New("im api call failed", "route", route, "status", response.StatusCode).Wrap()
+	}
+
+	var resp baseApiResponse[Resp]
+	err = json.Unmarshal(raw, &resp)
+	if err != nil {
+		return nil, errs.WrapMsg(err, "invalid im api response", "route", route)
+	}
+	if resp.ErrCode != 0 {
+		return nil, errs.NewCodeError(resp.ErrCode, resp.ErrMsg).WithDetail(resp.ErrDlt).Wrap()
+	}
+	if resp.Data == nil {
+		resp.Data = new(Resp)
+	}
+
+	return resp.Data, nil
+}
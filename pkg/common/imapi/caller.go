@@ -1,16 +1,26 @@
 package imapi
 
 import (
+	"context"
 	"sync"
+	"time"
 )
 
+// tokenRefreshMargin renews the admin token this long before the IM expires it.
+const tokenRefreshMargin = 5 * time.Minute
+
 type CallerInterface interface {
+	// FindGroupInfo returns the groups known to the IM among groupIDs; unknown IDs are
+	// left out.
+	FindGroupInfo(ctx context.Context, groupIDs []string) ([]*GroupInfo, error)
 }
 
 type Caller struct {
 	imApi           string
 	imSecret        string
 	defaultIMUserID string
+	token           string
+	tokenExpireAt   time.Time
 	lock            sync.RWMutex
 }
 
@@ -22,3 +32,52 @@ func New(imApi, imSecret, defaultIMUserID string) CallerInterface {
 		lock:            sync.RWMutex{},
 	}
 }
+
+// adminToken returns the token of the default IM admin, asking the IM for a new one
+// when the cached token is about to expire.
+func (c *Caller) adminToken(ctx context.Context) (string, error) {
+	c.lock.RLock()
+	token, expireAt := c.token, c.tokenExpireAt
+	c.lock.RUnlock()
+	if token != "" && time.Now().Before(expireAt) {
+		return token, nil
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.token != "" && time.Now().Before(c.tokenExpireAt) {
+		return c.token, nil
+	}
+
+	resp, err := call[adminTokenReq, adminTokenResp](ctx, c.imApi, routeAdminToken, "", &adminTokenReq{
+		Secret: c.imSecret,
+		UserID: c.defaultIMUserID,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	c.token = resp.Token
+	c.tokenExpireAt = time.Now().Add(time.Duration(resp.ExpireTimeSeconds)*time.Second - tokenRefreshMargin)
+	return c.token, nil
+}
+
+func (c *Caller) FindGroupInfo(ctx context.Context, groupIDs []string) ([]*GroupInfo, error) {
+	if len(groupIDs) == 0 {
+		return []*GroupInfo{}, nil
+	}
+
+	token, err := c.adminToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := call[getGroupsInfoReq, getGroupsInfoResp](ctx, c.imApi, routeGetGroupsInfo, token, &getGroupsInfoReq{
+		GroupIDs: groupIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.GroupInfos, nil
+}
@@ -0,0 +1,53 @@
+package imapi
+
+// Group status values of the IM.
+const (
+	GroupStatusOk        = 0
+	GroupStatusBanned    = 1
+	GroupStatusDismissed = 2
+	GroupStatusMuted     = 3
+)
+
+// Join policies of an IM group. A group where everyone, invited or not, needs approval
+// to join is private.
+const (
+	GroupApplyNeedVerificationInviteDirectly = 0
+	GroupAllNeedVerification                 = 1
+	GroupDirectly                            = 2
+)
+
+type adminTokenReq struct {
+	Secret string `json:"secret"`
+	UserID string `json:"userID"`
+}
+
+type adminTokenResp struct {
+	Token             string `json:"token"`
+	ExpireTimeSeconds int64  `json:"expireTimeSeconds"`
+}
+
+type getGroupsInfoReq struct {
+	GroupIDs []string `json:"groupIDs"`
+}
+
+type getGroupsInfoResp struct {
+	GroupInfos []*GroupInfo `json:"groupInfos"`
+}
+
+// GroupInfo is the group as returned by the IM group API.
+type GroupInfo struct {
+	GroupID          string `json:"groupID"`
+	GroupName        string `json:"groupName"`
+	FaceURL          string `json:"faceURL"`
+	Introduction     string `json:"introduction"`
+	MemberCount      int64  `json:"memberCount"`
+	Status           int32  `json:"status"`
+	NeedVerification int32  `json:"needVerification"`
+}
+
+// IsPublic reports whether the group is active (neither banned nor dismissed) and can be
+// joined without an invitation.
+func (g *GroupInfo) IsPublic() bool {
+	active := g.Status == GroupStatusOk || g.Status == GroupStatusMuted
+	return active && g.NeedVerification != GroupAllNeedVerification
+}
@@ -20,3 +20,20 @@ const (
 const (
 	ErrorTokenNotExist = 20101 + iota
 )
+
+const (
+	ErrorCodeInvalidStatusTransition = 20201 + iota
+	ErrorCodePositionTaken
+	ErrorCodeReorderMismatch
+	ErrorCodeItemNotFound
+	ErrorCodeCategoryInUse
+	ErrorCodeAlreadyVoted
+	ErrorCodePollClosed
+	ErrorCodeEventFull
+	ErrorCodeAlreadyJoined
+	ErrorCodeEventEnded
+	ErrorCodeInCampaign
+	ErrorCodeTrackingBusy
+	ErrorCodeLinkNotAllowed
+	ErrorCodeExperimentChanged
+)
@@ -19,4 +19,19 @@ var (
 	ErrEmailAlreadyRegister     = errs.NewCodeError(ErrorCodeEmailAlreadyRegister, "EmailAlreadyRegister")
 
 	ErrTokenNotExist = errs.NewCodeError(ErrorTokenNotExist, "ErrTokenNotExist")
+
+	ErrInvalidStatusTransition = errs.NewCodeError(ErrorCodeInvalidStatusTransition, "InvalidStatusTransition")
+	ErrPositionTaken           = errs.NewCodeError(ErrorCodePositionTaken, "PositionTaken")
+	ErrReorderMismatch         = errs.NewCodeError(ErrorCodeReorderMismatch, "ReorderMismatch")
+	ErrItemNotFound            = errs.NewCodeError(ErrorCodeItemNotFound, "ItemNotFound")
+	ErrCategoryInUse           = errs.NewCodeError(ErrorCodeCategoryInUse, "CategoryInUse")
+	ErrAlreadyVoted            = errs.NewCodeError(ErrorCodeAlreadyVoted, "AlreadyVoted")
+	ErrPollClosed              = errs.NewCodeError(ErrorCodePollClosed, "PollClosed")
+	ErrEventFull               = errs.NewCodeError(ErrorCodeEventFull, "EventFull")
+	ErrAlreadyJoined           = errs.NewCodeError(ErrorCodeAlreadyJoined, "AlreadyJoined")
+	ErrEventEnded              = errs.NewCodeError(ErrorCodeEventEnded, "EventEnded")
+	ErrInCampaign              = errs.NewCodeError(ErrorCodeInCampaign, "InCampaign")
+	ErrTrackingBusy            = errs.NewCodeError(ErrorCodeTrackingBusy, "TrackingBusy")
+	ErrLinkNotAllowed          = errs.NewCodeError(ErrorCodeLinkNotAllowed, "LinkNotAllowed")
+	ErrExperimentChanged       = errs.NewCodeError(ErrorCodeExperimentChanged, "ExperimentChanged")
 )
@@ -11,6 +11,9 @@ import (
 	"github.com/1nterdigital/aka-im-tools/utils/idutil"
 )
 
+// ErrFull is returned by TryPut when the data channel has no room left for the data.
+var ErrFull = errs.New("batcher is full")
+
 var (
 	DefaultDataChanSize = 1000
 	DefaultSize         = 100
@@ -77,6 +80,7 @@ type Batcher[T any] struct {
 	Key        func(data *T) string
 	HookFunc   func(triggerID string, messages map[string][]*T, totalCount int, lastMessage *T)
 	data       chan *T
+	putMu      sync.Mutex
 	chArrays   []chan *Msg[T]
 	wait       sync.WaitGroup
 	counter    sync.WaitGroup
@@ -92,16 +96,17 @@ func New[T any](opts ...Option) *Batcher[T] {
 		HookFunc:   emptyHookFunc[T],
 	}
 	config := &Config{
-		size:     DefaultSize,
-		buffer:   DefaultBuffer,
-		worker:   DefaultWorker,
-		interval: DefaultInterval,
+		size:       DefaultSize,
+		buffer:     DefaultBuffer,
+		dataBuffer: DefaultDataChanSize,
+		worker:     DefaultWorker,
+		interval:   DefaultInterval,
 	}
 	for _, opt := range opts {
 		opt(config)
 	}
 	b.config = config
-	b.data = make(chan *T, DefaultDataChanSize)
+	b.data = make(chan *T, b.config.dataBuffer)
 	b.globalCtx, b.cancel = context.WithCancel(context.Background())
 
 	b.chArrays = make([]chan *Msg[T], b.config.worker)
@@ -148,6 +153,35 @@ func (b *Batcher[T]) Put(ctx context.Context, data *T) error {
 	}
 }
 
+// TryPut adds all of data, or none of it when the data channel has no room left for
+// all of them, so that callers can shed load instead of blocking like Put does.
+func (b *Batcher[T]) TryPut(data []*T) error {
+	for _, item := range data {
+		if item == nil {
+			return errs.New("data can not be nil").Wrap()
+		}
+	}
+
+	// Producers going through TryPut are serialized, so the room checked below is
+	// only ever taken by Put callers or given back by the scheduler.
+	b.putMu.Lock()
+	defer b.putMu.Unlock()
+
+	select {
+	case <-b.globalCtx.Done():
+		return errs.New("data channel is closed").Wrap()
+	default:
+	}
+
+	if cap(b.data)-len(b.data) < len(data) {
+		return ErrFull
+	}
+	for _, item := range data {
+		b.data <- item
+	}
+	return nil
+}
+
 func (b *Batcher[T]) scheduler() {
 	ticker := time.NewTicker(b.config.interval)
 	defer func() {
@@ -261,7 +295,11 @@ func (b *Batcher[T]) run(channelID int, ch <-chan *Msg[T]) {
 }
 
 func (b *Batcher[T]) Close() {
+	// Holding putMu waits for a TryPut past its closed check, so that none of its data
+	// is sent after the nil that makes the scheduler close the channel.
+	b.putMu.Lock()
 	b.cancel() // Signal to stop put data
 	b.data <- nil
+	b.putMu.Unlock()
 	b.wait.Wait()
 }
@@ -0,0 +1,41 @@
+// Package markdown renders article bodies written by admins into HTML that is
+// safe to embed in the client.
+package markdown
+
+import (
+	"github.com/gomarkdown/markdown"
+	"github.com/gomarkdown/markdown/html"
+	"github.com/gomarkdown/markdown/parser"
+	"github.com/microcosm-cc/bluemonday"
+)
+
+// AllowedSchemes are the URL schemes kept on links and images; anything else
+// (javascript:, data:, ...) is stripped.
+var AllowedSchemes = []string{"https", "http", "mailto"}
+
+var policy = newPolicy()
+
+func newPolicy() *bluemonday.Policy {
+	p := bluemonday.UGCPolicy()
+	p.AllowURLSchemes(AllowedSchemes...)
+	p.RequireParseableURLs(true)
+	p.RequireNoFollowOnLinks(true)
+	p.AddTargetBlankToFullyQualifiedLinks(true)
+	return p
+}
+
+// Render converts Markdown to sanitized HTML. Raw HTML in the source is dropped
+// by the renderer and whatever remains is filtered by the sanitizer.
+func Render(source string) string {
+	if source == "" {
+		return ""
+	}
+
+	p := parser.NewWithExtensions(parser.CommonExtensions | parser.AutoHeadingIDs)
+	renderer := html.NewRenderer(html.RendererOptions{
+		Flags: html.CommonFlags | html.SkipHTML | html.HrefTargetBlank,
+	})
+
+	unsafe := markdown.ToHTML([]byte(source), p, renderer)
+	return string(policy.SanitizeBytes(unsafe))
+}